@@ -0,0 +1,219 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package balancer
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// BPoolABI is the input ABI used to generate the binding from.
+const BPoolABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"tokenIn\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenAmountIn\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"tokenOut\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"minAmountOut\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"maxPrice\",\"type\":\"uint256\"}],\"name\":\"swapExactAmountIn\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenAmountOut\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"spotPriceAfter\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCurrentTokens\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"tokens\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]"
+
+// BPool is an auto generated Go binding around an Ethereum contract.
+type BPool struct {
+	BPoolCaller     // Read-only binding to the contract
+	BPoolTransactor // Write-only binding to the contract
+	BPoolFilterer   // Log filterer for contract events
+}
+
+// BPoolCaller is an auto generated read-only Go binding around an Ethereum contract.
+type BPoolCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BPoolTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type BPoolTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BPoolFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type BPoolFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BPoolSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type BPoolSession struct {
+	Contract     *BPool            // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// BPoolCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type BPoolCallerSession struct {
+	Contract *BPoolCaller  // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// BPoolTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type BPoolTransactorSession struct {
+	Contract     *BPoolTransactor  // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// BPoolRaw is an auto generated low-level Go binding around an Ethereum contract.
+type BPoolRaw struct {
+	Contract *BPool // Generic contract binding to access the raw methods on
+}
+
+// BPoolCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type BPoolCallerRaw struct {
+	Contract *BPoolCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// BPoolTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type BPoolTransactorRaw struct {
+	Contract *BPoolTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewBPool creates a new instance of BPool, bound to a specific deployed contract.
+func NewBPool(address common.Address, backend bind.ContractBackend) (*BPool, error) {
+	contract, err := bindBPool(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &BPool{BPoolCaller: BPoolCaller{contract: contract}, BPoolTransactor: BPoolTransactor{contract: contract}, BPoolFilterer: BPoolFilterer{contract: contract}}, nil
+}
+
+// NewBPoolCaller creates a new read-only instance of BPool, bound to a specific deployed contract.
+func NewBPoolCaller(address common.Address, caller bind.ContractCaller) (*BPoolCaller, error) {
+	contract, err := bindBPool(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BPoolCaller{contract: contract}, nil
+}
+
+// NewBPoolTransactor creates a new write-only instance of BPool, bound to a specific deployed contract.
+func NewBPoolTransactor(address common.Address, transactor bind.ContractTransactor) (*BPoolTransactor, error) {
+	contract, err := bindBPool(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BPoolTransactor{contract: contract}, nil
+}
+
+// NewBPoolFilterer creates a new log filterer instance of BPool, bound to a specific deployed contract.
+func NewBPoolFilterer(address common.Address, filterer bind.ContractFilterer) (*BPoolFilterer, error) {
+	contract, err := bindBPool(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &BPoolFilterer{contract: contract}, nil
+}
+
+// bindBPool binds a generic wrapper to an already deployed contract.
+func bindBPool(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(BPoolABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BPool *BPoolRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _BPool.Contract.BPoolCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BPool *BPoolRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BPool.Contract.BPoolTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BPool *BPoolRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BPool.Contract.BPoolTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BPool *BPoolCallerRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _BPool.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BPool *BPoolTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BPool.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BPool *BPoolTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BPool.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetCurrentTokens is a free data retrieval call binding the contract method 0xcc77828d.
+//
+// Solidity: function getCurrentTokens() view returns(address[] tokens)
+func (_BPool *BPoolCaller) GetCurrentTokens(opts *bind.CallOpts) ([]common.Address, error) {
+	var (
+		ret0 = new([]common.Address)
+	)
+	out := ret0
+	err := _BPool.contract.Call(opts, out, "getCurrentTokens")
+	return *ret0, err
+}
+
+// GetCurrentTokens is a free data retrieval call binding the contract method 0xcc77828d.
+//
+// Solidity: function getCurrentTokens() view returns(address[] tokens)
+func (_BPool *BPoolSession) GetCurrentTokens() ([]common.Address, error) {
+	return _BPool.Contract.GetCurrentTokens(&_BPool.CallOpts)
+}
+
+// GetCurrentTokens is a free data retrieval call binding the contract method 0xcc77828d.
+//
+// Solidity: function getCurrentTokens() view returns(address[] tokens)
+func (_BPool *BPoolCallerSession) GetCurrentTokens() ([]common.Address, error) {
+	return _BPool.Contract.GetCurrentTokens(&_BPool.CallOpts)
+}
+
+// SwapExactAmountIn is a paid mutator transaction binding the contract method 0x8201aa3f.
+//
+// Solidity: function swapExactAmountIn(address tokenIn, uint256 tokenAmountIn, address tokenOut, uint256 minAmountOut, uint256 maxPrice) returns(uint256 tokenAmountOut, uint256 spotPriceAfter)
+func (_BPool *BPoolTransactor) SwapExactAmountIn(opts *bind.TransactOpts, tokenIn common.Address, tokenAmountIn *big.Int, tokenOut common.Address, minAmountOut *big.Int, maxPrice *big.Int) (*types.Transaction, error) {
+	return _BPool.contract.Transact(opts, "swapExactAmountIn", tokenIn, tokenAmountIn, tokenOut, minAmountOut, maxPrice)
+}
+
+// SwapExactAmountIn is a paid mutator transaction binding the contract method 0x8201aa3f.
+//
+// Solidity: function swapExactAmountIn(address tokenIn, uint256 tokenAmountIn, address tokenOut, uint256 minAmountOut, uint256 maxPrice) returns(uint256 tokenAmountOut, uint256 spotPriceAfter)
+func (_BPool *BPoolSession) SwapExactAmountIn(tokenIn common.Address, tokenAmountIn *big.Int, tokenOut common.Address, minAmountOut *big.Int, maxPrice *big.Int) (*types.Transaction, error) {
+	return _BPool.Contract.SwapExactAmountIn(&_BPool.TransactOpts, tokenIn, tokenAmountIn, tokenOut, minAmountOut, maxPrice)
+}
+
+// SwapExactAmountIn is a paid mutator transaction binding the contract method 0x8201aa3f.
+//
+// Solidity: function swapExactAmountIn(address tokenIn, uint256 tokenAmountIn, address tokenOut, uint256 minAmountOut, uint256 maxPrice) returns(uint256 tokenAmountOut, uint256 spotPriceAfter)
+func (_BPool *BPoolTransactorSession) SwapExactAmountIn(tokenIn common.Address, tokenAmountIn *big.Int, tokenOut common.Address, minAmountOut *big.Int, maxPrice *big.Int) (*types.Transaction, error) {
+	return _BPool.Contract.SwapExactAmountIn(&_BPool.TransactOpts, tokenIn, tokenAmountIn, tokenOut, minAmountOut, maxPrice)
+}
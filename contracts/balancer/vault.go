@@ -0,0 +1,241 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package balancer
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// IVaultBatchSwapStep is an auto generated low-level Go binding around an user-defined struct.
+type IVaultBatchSwapStep struct {
+	PoolId        [32]byte
+	AssetInIndex  *big.Int
+	AssetOutIndex *big.Int
+	Amount        *big.Int
+	UserData      []byte
+}
+
+// IVaultFundManagement is an auto generated low-level Go binding around an user-defined struct.
+type IVaultFundManagement struct {
+	Sender              common.Address
+	FromInternalBalance bool
+	Recipient           common.Address
+	ToInternalBalance   bool
+}
+
+// IVaultSingleSwap is an auto generated low-level Go binding around an user-defined struct.
+type IVaultSingleSwap struct {
+	PoolId   [32]byte
+	Kind     uint8
+	AssetIn  common.Address
+	AssetOut common.Address
+	Amount   *big.Int
+	UserData []byte
+}
+
+// VaultABI is the input ABI used to generate the binding from.
+const VaultABI = "[{\"inputs\":[{\"internalType\":\"structIVault.SingleSwap\",\"name\":\"singleSwap\",\"type\":\"tuple\",\"components\":[{\"internalType\":\"bytes32\",\"name\":\"poolId\",\"type\":\"bytes32\"},{\"internalType\":\"enumIVault.SwapKind\",\"name\":\"kind\",\"type\":\"uint8\"},{\"internalType\":\"contractIAsset\",\"name\":\"assetIn\",\"type\":\"address\"},{\"internalType\":\"contractIAsset\",\"name\":\"assetOut\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"userData\",\"type\":\"bytes\"}]},{\"internalType\":\"structIVault.FundManagement\",\"name\":\"funds\",\"type\":\"tuple\",\"components\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"fromInternalBalance\",\"type\":\"bool\"},{\"internalType\":\"addresspayable\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"toInternalBalance\",\"type\":\"bool\"}]},{\"internalType\":\"uint256\",\"name\":\"limit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"deadline\",\"type\":\"uint256\"}],\"name\":\"swap\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"amountCalculated\",\"type\":\"uint256\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"enumIVault.SwapKind\",\"name\":\"kind\",\"type\":\"uint8\"},{\"internalType\":\"structIVault.BatchSwapStep[]\",\"name\":\"swaps\",\"type\":\"tuple[]\",\"components\":[{\"internalType\":\"bytes32\",\"name\":\"poolId\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"assetInIndex\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"assetOutIndex\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"userData\",\"type\":\"bytes\"}]},{\"internalType\":\"contractIAsset[]\",\"name\":\"assets\",\"type\":\"address[]\"},{\"internalType\":\"structIVault.FundManagement\",\"name\":\"funds\",\"type\":\"tuple\",\"components\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"fromInternalBalance\",\"type\":\"bool\"},{\"internalType\":\"addresspayable\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"toInternalBalance\",\"type\":\"bool\"}]},{\"internalType\":\"int256[]\",\"name\":\"limits\",\"type\":\"int256[]\"},{\"internalType\":\"uint256\",\"name\":\"deadline\",\"type\":\"uint256\"}],\"name\":\"batchSwap\",\"outputs\":[{\"internalType\":\"int256[]\",\"name\":\"assetDeltas\",\"type\":\"int256[]\"}],\"stateMutability\":\"payable\",\"type\":\"function\"}]"
+
+// Vault is an auto generated Go binding around an Ethereum contract.
+type Vault struct {
+	VaultCaller     // Read-only binding to the contract
+	VaultTransactor // Write-only binding to the contract
+	VaultFilterer   // Log filterer for contract events
+}
+
+// VaultCaller is an auto generated read-only Go binding around an Ethereum contract.
+type VaultCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VaultTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type VaultTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VaultFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type VaultFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VaultSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type VaultSession struct {
+	Contract     *Vault            // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// VaultCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type VaultCallerSession struct {
+	Contract *VaultCaller  // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// VaultTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type VaultTransactorSession struct {
+	Contract     *VaultTransactor  // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// VaultRaw is an auto generated low-level Go binding around an Ethereum contract.
+type VaultRaw struct {
+	Contract *Vault // Generic contract binding to access the raw methods on
+}
+
+// VaultCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type VaultCallerRaw struct {
+	Contract *VaultCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// VaultTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type VaultTransactorRaw struct {
+	Contract *VaultTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewVault creates a new instance of Vault, bound to a specific deployed contract.
+func NewVault(address common.Address, backend bind.ContractBackend) (*Vault, error) {
+	contract, err := bindVault(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Vault{VaultCaller: VaultCaller{contract: contract}, VaultTransactor: VaultTransactor{contract: contract}, VaultFilterer: VaultFilterer{contract: contract}}, nil
+}
+
+// NewVaultCaller creates a new read-only instance of Vault, bound to a specific deployed contract.
+func NewVaultCaller(address common.Address, caller bind.ContractCaller) (*VaultCaller, error) {
+	contract, err := bindVault(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &VaultCaller{contract: contract}, nil
+}
+
+// NewVaultTransactor creates a new write-only instance of Vault, bound to a specific deployed contract.
+func NewVaultTransactor(address common.Address, transactor bind.ContractTransactor) (*VaultTransactor, error) {
+	contract, err := bindVault(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &VaultTransactor{contract: contract}, nil
+}
+
+// NewVaultFilterer creates a new log filterer instance of Vault, bound to a specific deployed contract.
+func NewVaultFilterer(address common.Address, filterer bind.ContractFilterer) (*VaultFilterer, error) {
+	contract, err := bindVault(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &VaultFilterer{contract: contract}, nil
+}
+
+// bindVault binds a generic wrapper to an already deployed contract.
+func bindVault(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(VaultABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Vault *VaultRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _Vault.Contract.VaultCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Vault *VaultRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Vault.Contract.VaultTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Vault *VaultRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Vault.Contract.VaultTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Vault *VaultCallerRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _Vault.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Vault *VaultTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Vault.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Vault *VaultTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Vault.Contract.contract.Transact(opts, method, params...)
+}
+
+// BatchSwap is a paid mutator transaction binding the contract method 0x945bcec9.
+//
+// Solidity: function batchSwap(uint8 kind, (bytes32,uint256,uint256,uint256,bytes)[] swaps, address[] assets, (address,bool,address,bool) funds, int256[] limits, uint256 deadline) payable returns(int256[] assetDeltas)
+func (_Vault *VaultTransactor) BatchSwap(opts *bind.TransactOpts, kind uint8, swaps []IVaultBatchSwapStep, assets []common.Address, funds IVaultFundManagement, limits []*big.Int, deadline *big.Int) (*types.Transaction, error) {
+	return _Vault.contract.Transact(opts, "batchSwap", kind, swaps, assets, funds, limits, deadline)
+}
+
+// BatchSwap is a paid mutator transaction binding the contract method 0x945bcec9.
+//
+// Solidity: function batchSwap(uint8 kind, (bytes32,uint256,uint256,uint256,bytes)[] swaps, address[] assets, (address,bool,address,bool) funds, int256[] limits, uint256 deadline) payable returns(int256[] assetDeltas)
+func (_Vault *VaultSession) BatchSwap(kind uint8, swaps []IVaultBatchSwapStep, assets []common.Address, funds IVaultFundManagement, limits []*big.Int, deadline *big.Int) (*types.Transaction, error) {
+	return _Vault.Contract.BatchSwap(&_Vault.TransactOpts, kind, swaps, assets, funds, limits, deadline)
+}
+
+// BatchSwap is a paid mutator transaction binding the contract method 0x945bcec9.
+//
+// Solidity: function batchSwap(uint8 kind, (bytes32,uint256,uint256,uint256,bytes)[] swaps, address[] assets, (address,bool,address,bool) funds, int256[] limits, uint256 deadline) payable returns(int256[] assetDeltas)
+func (_Vault *VaultTransactorSession) BatchSwap(kind uint8, swaps []IVaultBatchSwapStep, assets []common.Address, funds IVaultFundManagement, limits []*big.Int, deadline *big.Int) (*types.Transaction, error) {
+	return _Vault.Contract.BatchSwap(&_Vault.TransactOpts, kind, swaps, assets, funds, limits, deadline)
+}
+
+// Swap is a paid mutator transaction binding the contract method 0x52bbbe29.
+//
+// Solidity: function swap((bytes32,uint8,address,address,uint256,bytes) singleSwap, (address,bool,address,bool) funds, uint256 limit, uint256 deadline) payable returns(uint256 amountCalculated)
+func (_Vault *VaultTransactor) Swap(opts *bind.TransactOpts, singleSwap IVaultSingleSwap, funds IVaultFundManagement, limit *big.Int, deadline *big.Int) (*types.Transaction, error) {
+	return _Vault.contract.Transact(opts, "swap", singleSwap, funds, limit, deadline)
+}
+
+// Swap is a paid mutator transaction binding the contract method 0x52bbbe29.
+//
+// Solidity: function swap((bytes32,uint8,address,address,uint256,bytes) singleSwap, (address,bool,address,bool) funds, uint256 limit, uint256 deadline) payable returns(uint256 amountCalculated)
+func (_Vault *VaultSession) Swap(singleSwap IVaultSingleSwap, funds IVaultFundManagement, limit *big.Int, deadline *big.Int) (*types.Transaction, error) {
+	return _Vault.Contract.Swap(&_Vault.TransactOpts, singleSwap, funds, limit, deadline)
+}
+
+// Swap is a paid mutator transaction binding the contract method 0x52bbbe29.
+//
+// Solidity: function swap((bytes32,uint8,address,address,uint256,bytes) singleSwap, (address,bool,address,bool) funds, uint256 limit, uint256 deadline) payable returns(uint256 amountCalculated)
+func (_Vault *VaultTransactorSession) Swap(singleSwap IVaultSingleSwap, funds IVaultFundManagement, limit *big.Int, deadline *big.Int) (*types.Transaction, error) {
+	return _Vault.Contract.Swap(&_Vault.TransactOpts, singleSwap, funds, limit, deadline)
+}
@@ -0,0 +1,825 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package chainlinkOCR
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// ChainlinkOCRABI is the input ABI used to generate the binding from.
+const ChainlinkOCRABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint32\",\"name\":\"aggregatorRoundId\",\"type\":\"uint32\"},{\"indexed\":false,\"internalType\":\"int192\",\"name\":\"answer\",\"type\":\"int192\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"transmitter\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"int192[]\",\"name\":\"observations\",\"type\":\"int192[]\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"observers\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"rawReportContext\",\"type\":\"bytes32\"}],\"name\":\"NewTransmission\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"int256\",\"name\":\"current\",\"type\":\"int256\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"roundId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"updatedAt\",\"type\":\"uint256\"}],\"name\":\"AnswerUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"roundId\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"startedBy\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"startedAt\",\"type\":\"uint256\"}],\"name\":\"NewRound\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"description\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"latestAnswer\",\"outputs\":[{\"internalType\":\"int256\",\"name\":\"\",\"type\":\"int256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"latestRound\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"latestTransmissionDetails\",\"outputs\":[{\"internalType\":\"bytes16\",\"name\":\"configDigest\",\"type\":\"bytes16\"},{\"internalType\":\"uint32\",\"name\":\"epoch\",\"type\":\"uint32\"},{\"internalType\":\"uint8\",\"name\":\"round\",\"type\":\"uint8\"},{\"internalType\":\"int192\",\"name\":\"latestAnswer\",\"type\":\"int192\"},{\"internalType\":\"uint64\",\"name\":\"latestTimestamp\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"transmitters\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_report\",\"type\":\"bytes\"},{\"internalType\":\"bytes32[]\",\"name\":\"_rs\",\"type\":\"bytes32[]\"},{\"internalType\":\"bytes32[]\",\"name\":\"_ss\",\"type\":\"bytes32[]\"},{\"internalType\":\"bytes32\",\"name\":\"_rawVs\",\"type\":\"bytes32\"}],\"name\":\"transmit\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+
+// ChainlinkOCR is an auto generated Go binding around an Ethereum contract.
+type ChainlinkOCR struct {
+	ChainlinkOCRCaller     // Read-only binding to the contract
+	ChainlinkOCRTransactor // Write-only binding to the contract
+	ChainlinkOCRFilterer   // Log filterer for contract events
+}
+
+// ChainlinkOCRCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ChainlinkOCRCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ChainlinkOCRTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ChainlinkOCRTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ChainlinkOCRFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ChainlinkOCRFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ChainlinkOCRSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ChainlinkOCRSession struct {
+	Contract     *ChainlinkOCR     // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ChainlinkOCRCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ChainlinkOCRCallerSession struct {
+	Contract *ChainlinkOCRCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts       // Call options to use throughout this session
+}
+
+// ChainlinkOCRTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ChainlinkOCRTransactorSession struct {
+	Contract     *ChainlinkOCRTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts       // Transaction auth options to use throughout this session
+}
+
+// ChainlinkOCRRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ChainlinkOCRRaw struct {
+	Contract *ChainlinkOCR // Generic contract binding to access the raw methods on
+}
+
+// ChainlinkOCRCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ChainlinkOCRCallerRaw struct {
+	Contract *ChainlinkOCRCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ChainlinkOCRTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ChainlinkOCRTransactorRaw struct {
+	Contract *ChainlinkOCRTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewChainlinkOCR creates a new instance of ChainlinkOCR, bound to a specific deployed contract.
+func NewChainlinkOCR(address common.Address, backend bind.ContractBackend) (*ChainlinkOCR, error) {
+	contract, err := bindChainlinkOCR(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ChainlinkOCR{ChainlinkOCRCaller: ChainlinkOCRCaller{contract: contract}, ChainlinkOCRTransactor: ChainlinkOCRTransactor{contract: contract}, ChainlinkOCRFilterer: ChainlinkOCRFilterer{contract: contract}}, nil
+}
+
+// NewChainlinkOCRCaller creates a new read-only instance of ChainlinkOCR, bound to a specific deployed contract.
+func NewChainlinkOCRCaller(address common.Address, caller bind.ContractCaller) (*ChainlinkOCRCaller, error) {
+	contract, err := bindChainlinkOCR(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ChainlinkOCRCaller{contract: contract}, nil
+}
+
+// NewChainlinkOCRTransactor creates a new write-only instance of ChainlinkOCR, bound to a specific deployed contract.
+func NewChainlinkOCRTransactor(address common.Address, transactor bind.ContractTransactor) (*ChainlinkOCRTransactor, error) {
+	contract, err := bindChainlinkOCR(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ChainlinkOCRTransactor{contract: contract}, nil
+}
+
+// NewChainlinkOCRFilterer creates a new log filterer instance of ChainlinkOCR, bound to a specific deployed contract.
+func NewChainlinkOCRFilterer(address common.Address, filterer bind.ContractFilterer) (*ChainlinkOCRFilterer, error) {
+	contract, err := bindChainlinkOCR(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ChainlinkOCRFilterer{contract: contract}, nil
+}
+
+// bindChainlinkOCR binds a generic wrapper to an already deployed contract.
+func bindChainlinkOCR(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(ChainlinkOCRABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ChainlinkOCR *ChainlinkOCRRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _ChainlinkOCR.Contract.ChainlinkOCRCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ChainlinkOCR *ChainlinkOCRRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ChainlinkOCR.Contract.ChainlinkOCRTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ChainlinkOCR *ChainlinkOCRRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ChainlinkOCR.Contract.ChainlinkOCRTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ChainlinkOCR *ChainlinkOCRCallerRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _ChainlinkOCR.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ChainlinkOCR *ChainlinkOCRTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ChainlinkOCR.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ChainlinkOCR *ChainlinkOCRTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ChainlinkOCR.Contract.contract.Transact(opts, method, params...)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_ChainlinkOCR *ChainlinkOCRCaller) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var (
+		ret0 = new(uint8)
+	)
+	out := ret0
+	err := _ChainlinkOCR.contract.Call(opts, out, "decimals")
+	return *ret0, err
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_ChainlinkOCR *ChainlinkOCRSession) Decimals() (uint8, error) {
+	return _ChainlinkOCR.Contract.Decimals(&_ChainlinkOCR.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_ChainlinkOCR *ChainlinkOCRCallerSession) Decimals() (uint8, error) {
+	return _ChainlinkOCR.Contract.Decimals(&_ChainlinkOCR.CallOpts)
+}
+
+// Description is a free data retrieval call binding the contract method 0x7284e416.
+//
+// Solidity: function description() view returns(string)
+func (_ChainlinkOCR *ChainlinkOCRCaller) Description(opts *bind.CallOpts) (string, error) {
+	var (
+		ret0 = new(string)
+	)
+	out := ret0
+	err := _ChainlinkOCR.contract.Call(opts, out, "description")
+	return *ret0, err
+}
+
+// Description is a free data retrieval call binding the contract method 0x7284e416.
+//
+// Solidity: function description() view returns(string)
+func (_ChainlinkOCR *ChainlinkOCRSession) Description() (string, error) {
+	return _ChainlinkOCR.Contract.Description(&_ChainlinkOCR.CallOpts)
+}
+
+// Description is a free data retrieval call binding the contract method 0x7284e416.
+//
+// Solidity: function description() view returns(string)
+func (_ChainlinkOCR *ChainlinkOCRCallerSession) Description() (string, error) {
+	return _ChainlinkOCR.Contract.Description(&_ChainlinkOCR.CallOpts)
+}
+
+// LatestAnswer is a free data retrieval call binding the contract method 0x50d25bcd.
+//
+// Solidity: function latestAnswer() view returns(int256)
+func (_ChainlinkOCR *ChainlinkOCRCaller) LatestAnswer(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ChainlinkOCR.contract.Call(opts, out, "latestAnswer")
+	return *ret0, err
+}
+
+// LatestAnswer is a free data retrieval call binding the contract method 0x50d25bcd.
+//
+// Solidity: function latestAnswer() view returns(int256)
+func (_ChainlinkOCR *ChainlinkOCRSession) LatestAnswer() (*big.Int, error) {
+	return _ChainlinkOCR.Contract.LatestAnswer(&_ChainlinkOCR.CallOpts)
+}
+
+// LatestAnswer is a free data retrieval call binding the contract method 0x50d25bcd.
+//
+// Solidity: function latestAnswer() view returns(int256)
+func (_ChainlinkOCR *ChainlinkOCRCallerSession) LatestAnswer() (*big.Int, error) {
+	return _ChainlinkOCR.Contract.LatestAnswer(&_ChainlinkOCR.CallOpts)
+}
+
+// LatestRound is a free data retrieval call binding the contract method 0x668a0f02.
+//
+// Solidity: function latestRound() view returns(uint256)
+func (_ChainlinkOCR *ChainlinkOCRCaller) LatestRound(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ChainlinkOCR.contract.Call(opts, out, "latestRound")
+	return *ret0, err
+}
+
+// LatestRound is a free data retrieval call binding the contract method 0x668a0f02.
+//
+// Solidity: function latestRound() view returns(uint256)
+func (_ChainlinkOCR *ChainlinkOCRSession) LatestRound() (*big.Int, error) {
+	return _ChainlinkOCR.Contract.LatestRound(&_ChainlinkOCR.CallOpts)
+}
+
+// LatestRound is a free data retrieval call binding the contract method 0x668a0f02.
+//
+// Solidity: function latestRound() view returns(uint256)
+func (_ChainlinkOCR *ChainlinkOCRCallerSession) LatestRound() (*big.Int, error) {
+	return _ChainlinkOCR.Contract.LatestRound(&_ChainlinkOCR.CallOpts)
+}
+
+// LatestTransmissionDetails is a free data retrieval call binding the contract method 0xe5fe4577.
+//
+// Solidity: function latestTransmissionDetails() view returns(bytes16 configDigest, uint32 epoch, uint8 round, int192 latestAnswer, uint64 latestTimestamp)
+func (_ChainlinkOCR *ChainlinkOCRCaller) LatestTransmissionDetails(opts *bind.CallOpts) (struct {
+	ConfigDigest    [16]byte
+	Epoch           uint32
+	Round           uint8
+	LatestAnswer    *big.Int
+	LatestTimestamp uint64
+}, error) {
+	ret := new(struct {
+		ConfigDigest    [16]byte
+		Epoch           uint32
+		Round           uint8
+		LatestAnswer    *big.Int
+		LatestTimestamp uint64
+	})
+	out := ret
+	err := _ChainlinkOCR.contract.Call(opts, out, "latestTransmissionDetails")
+	return *ret, err
+}
+
+// LatestTransmissionDetails is a free data retrieval call binding the contract method 0xe5fe4577.
+//
+// Solidity: function latestTransmissionDetails() view returns(bytes16 configDigest, uint32 epoch, uint8 round, int192 latestAnswer, uint64 latestTimestamp)
+func (_ChainlinkOCR *ChainlinkOCRSession) LatestTransmissionDetails() (struct {
+	ConfigDigest    [16]byte
+	Epoch           uint32
+	Round           uint8
+	LatestAnswer    *big.Int
+	LatestTimestamp uint64
+}, error) {
+	return _ChainlinkOCR.Contract.LatestTransmissionDetails(&_ChainlinkOCR.CallOpts)
+}
+
+// LatestTransmissionDetails is a free data retrieval call binding the contract method 0xe5fe4577.
+//
+// Solidity: function latestTransmissionDetails() view returns(bytes16 configDigest, uint32 epoch, uint8 round, int192 latestAnswer, uint64 latestTimestamp)
+func (_ChainlinkOCR *ChainlinkOCRCallerSession) LatestTransmissionDetails() (struct {
+	ConfigDigest    [16]byte
+	Epoch           uint32
+	Round           uint8
+	LatestAnswer    *big.Int
+	LatestTimestamp uint64
+}, error) {
+	return _ChainlinkOCR.Contract.LatestTransmissionDetails(&_ChainlinkOCR.CallOpts)
+}
+
+// Transmitters is a free data retrieval call binding the contract method 0x81411834.
+//
+// Solidity: function transmitters() view returns(address[])
+func (_ChainlinkOCR *ChainlinkOCRCaller) Transmitters(opts *bind.CallOpts) ([]common.Address, error) {
+	var (
+		ret0 = new([]common.Address)
+	)
+	out := ret0
+	err := _ChainlinkOCR.contract.Call(opts, out, "transmitters")
+	return *ret0, err
+}
+
+// Transmitters is a free data retrieval call binding the contract method 0x81411834.
+//
+// Solidity: function transmitters() view returns(address[])
+func (_ChainlinkOCR *ChainlinkOCRSession) Transmitters() ([]common.Address, error) {
+	return _ChainlinkOCR.Contract.Transmitters(&_ChainlinkOCR.CallOpts)
+}
+
+// Transmitters is a free data retrieval call binding the contract method 0x81411834.
+//
+// Solidity: function transmitters() view returns(address[])
+func (_ChainlinkOCR *ChainlinkOCRCallerSession) Transmitters() ([]common.Address, error) {
+	return _ChainlinkOCR.Contract.Transmitters(&_ChainlinkOCR.CallOpts)
+}
+
+// Transmit is a paid mutator transaction binding the contract method 0xc9807539.
+//
+// Solidity: function transmit(bytes _report, bytes32[] _rs, bytes32[] _ss, bytes32 _rawVs) returns()
+func (_ChainlinkOCR *ChainlinkOCRTransactor) Transmit(opts *bind.TransactOpts, _report []byte, _rs [][32]byte, _ss [][32]byte, _rawVs [32]byte) (*types.Transaction, error) {
+	return _ChainlinkOCR.contract.Transact(opts, "transmit", _report, _rs, _ss, _rawVs)
+}
+
+// Transmit is a paid mutator transaction binding the contract method 0xc9807539.
+//
+// Solidity: function transmit(bytes _report, bytes32[] _rs, bytes32[] _ss, bytes32 _rawVs) returns()
+func (_ChainlinkOCR *ChainlinkOCRSession) Transmit(_report []byte, _rs [][32]byte, _ss [][32]byte, _rawVs [32]byte) (*types.Transaction, error) {
+	return _ChainlinkOCR.Contract.Transmit(&_ChainlinkOCR.TransactOpts, _report, _rs, _ss, _rawVs)
+}
+
+// Transmit is a paid mutator transaction binding the contract method 0xc9807539.
+//
+// Solidity: function transmit(bytes _report, bytes32[] _rs, bytes32[] _ss, bytes32 _rawVs) returns()
+func (_ChainlinkOCR *ChainlinkOCRTransactorSession) Transmit(_report []byte, _rs [][32]byte, _ss [][32]byte, _rawVs [32]byte) (*types.Transaction, error) {
+	return _ChainlinkOCR.Contract.Transmit(&_ChainlinkOCR.TransactOpts, _report, _rs, _ss, _rawVs)
+}
+
+// ChainlinkOCRAnswerUpdatedIterator is returned from FilterAnswerUpdated and is used to iterate over the raw logs and unpacked data for AnswerUpdated events raised by the ChainlinkOCR contract.
+type ChainlinkOCRAnswerUpdatedIterator struct {
+	Event *ChainlinkOCRAnswerUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ChainlinkOCRAnswerUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ChainlinkOCRAnswerUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ChainlinkOCRAnswerUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ChainlinkOCRAnswerUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ChainlinkOCRAnswerUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ChainlinkOCRAnswerUpdated represents a AnswerUpdated event raised by the ChainlinkOCR contract.
+type ChainlinkOCRAnswerUpdated struct {
+	Current   *big.Int
+	RoundId   *big.Int
+	UpdatedAt *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterAnswerUpdated is a free log retrieval operation binding the contract event 0x0559884fd3a460db3073b7fc896cc77986f16e378210ded43186175bf646fc5f.
+//
+// Solidity: event AnswerUpdated(int256 indexed current, uint256 indexed roundId, uint256 updatedAt)
+func (_ChainlinkOCR *ChainlinkOCRFilterer) FilterAnswerUpdated(opts *bind.FilterOpts, current []*big.Int, roundId []*big.Int) (*ChainlinkOCRAnswerUpdatedIterator, error) {
+
+	var currentRule []interface{}
+	for _, currentItem := range current {
+		currentRule = append(currentRule, currentItem)
+	}
+	var roundIdRule []interface{}
+	for _, roundIdItem := range roundId {
+		roundIdRule = append(roundIdRule, roundIdItem)
+	}
+
+	logs, sub, err := _ChainlinkOCR.contract.FilterLogs(opts, "AnswerUpdated", currentRule, roundIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ChainlinkOCRAnswerUpdatedIterator{contract: _ChainlinkOCR.contract, event: "AnswerUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchAnswerUpdated is a free log subscription operation binding the contract event 0x0559884fd3a460db3073b7fc896cc77986f16e378210ded43186175bf646fc5f.
+//
+// Solidity: event AnswerUpdated(int256 indexed current, uint256 indexed roundId, uint256 updatedAt)
+func (_ChainlinkOCR *ChainlinkOCRFilterer) WatchAnswerUpdated(opts *bind.WatchOpts, sink chan<- *ChainlinkOCRAnswerUpdated, current []*big.Int, roundId []*big.Int) (event.Subscription, error) {
+
+	var currentRule []interface{}
+	for _, currentItem := range current {
+		currentRule = append(currentRule, currentItem)
+	}
+	var roundIdRule []interface{}
+	for _, roundIdItem := range roundId {
+		roundIdRule = append(roundIdRule, roundIdItem)
+	}
+
+	logs, sub, err := _ChainlinkOCR.contract.WatchLogs(opts, "AnswerUpdated", currentRule, roundIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ChainlinkOCRAnswerUpdated)
+				if err := _ChainlinkOCR.contract.UnpackLog(event, "AnswerUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAnswerUpdated is a log parse operation binding the contract event 0x0559884fd3a460db3073b7fc896cc77986f16e378210ded43186175bf646fc5f.
+//
+// Solidity: event AnswerUpdated(int256 indexed current, uint256 indexed roundId, uint256 updatedAt)
+func (_ChainlinkOCR *ChainlinkOCRFilterer) ParseAnswerUpdated(log types.Log) (*ChainlinkOCRAnswerUpdated, error) {
+	event := new(ChainlinkOCRAnswerUpdated)
+	if err := _ChainlinkOCR.contract.UnpackLog(event, "AnswerUpdated", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// ChainlinkOCRNewRoundIterator is returned from FilterNewRound and is used to iterate over the raw logs and unpacked data for NewRound events raised by the ChainlinkOCR contract.
+type ChainlinkOCRNewRoundIterator struct {
+	Event *ChainlinkOCRNewRound // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ChainlinkOCRNewRoundIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ChainlinkOCRNewRound)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ChainlinkOCRNewRound)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ChainlinkOCRNewRoundIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ChainlinkOCRNewRoundIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ChainlinkOCRNewRound represents a NewRound event raised by the ChainlinkOCR contract.
+type ChainlinkOCRNewRound struct {
+	RoundId   *big.Int
+	StartedBy common.Address
+	StartedAt *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterNewRound is a free log retrieval operation binding the contract event 0x0109fc6f55cf40689f02fbaad7af7fe7bbac8a3d2186600afc7d3e10cac60271.
+//
+// Solidity: event NewRound(uint256 indexed roundId, address indexed startedBy, uint256 startedAt)
+func (_ChainlinkOCR *ChainlinkOCRFilterer) FilterNewRound(opts *bind.FilterOpts, roundId []*big.Int, startedBy []common.Address) (*ChainlinkOCRNewRoundIterator, error) {
+
+	var roundIdRule []interface{}
+	for _, roundIdItem := range roundId {
+		roundIdRule = append(roundIdRule, roundIdItem)
+	}
+	var startedByRule []interface{}
+	for _, startedByItem := range startedBy {
+		startedByRule = append(startedByRule, startedByItem)
+	}
+
+	logs, sub, err := _ChainlinkOCR.contract.FilterLogs(opts, "NewRound", roundIdRule, startedByRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ChainlinkOCRNewRoundIterator{contract: _ChainlinkOCR.contract, event: "NewRound", logs: logs, sub: sub}, nil
+}
+
+// WatchNewRound is a free log subscription operation binding the contract event 0x0109fc6f55cf40689f02fbaad7af7fe7bbac8a3d2186600afc7d3e10cac60271.
+//
+// Solidity: event NewRound(uint256 indexed roundId, address indexed startedBy, uint256 startedAt)
+func (_ChainlinkOCR *ChainlinkOCRFilterer) WatchNewRound(opts *bind.WatchOpts, sink chan<- *ChainlinkOCRNewRound, roundId []*big.Int, startedBy []common.Address) (event.Subscription, error) {
+
+	var roundIdRule []interface{}
+	for _, roundIdItem := range roundId {
+		roundIdRule = append(roundIdRule, roundIdItem)
+	}
+	var startedByRule []interface{}
+	for _, startedByItem := range startedBy {
+		startedByRule = append(startedByRule, startedByItem)
+	}
+
+	logs, sub, err := _ChainlinkOCR.contract.WatchLogs(opts, "NewRound", roundIdRule, startedByRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ChainlinkOCRNewRound)
+				if err := _ChainlinkOCR.contract.UnpackLog(event, "NewRound", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseNewRound is a log parse operation binding the contract event 0x0109fc6f55cf40689f02fbaad7af7fe7bbac8a3d2186600afc7d3e10cac60271.
+//
+// Solidity: event NewRound(uint256 indexed roundId, address indexed startedBy, uint256 startedAt)
+func (_ChainlinkOCR *ChainlinkOCRFilterer) ParseNewRound(log types.Log) (*ChainlinkOCRNewRound, error) {
+	event := new(ChainlinkOCRNewRound)
+	if err := _ChainlinkOCR.contract.UnpackLog(event, "NewRound", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// ChainlinkOCRNewTransmissionIterator is returned from FilterNewTransmission and is used to iterate over the raw logs and unpacked data for NewTransmission events raised by the ChainlinkOCR contract.
+type ChainlinkOCRNewTransmissionIterator struct {
+	Event *ChainlinkOCRNewTransmission // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ChainlinkOCRNewTransmissionIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ChainlinkOCRNewTransmission)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ChainlinkOCRNewTransmission)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ChainlinkOCRNewTransmissionIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ChainlinkOCRNewTransmissionIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ChainlinkOCRNewTransmission represents a NewTransmission event raised by the ChainlinkOCR contract.
+type ChainlinkOCRNewTransmission struct {
+	AggregatorRoundId uint32
+	Answer            *big.Int
+	Transmitter       common.Address
+	Observations      []*big.Int
+	Observers         []byte
+	RawReportContext  [32]byte
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterNewTransmission is a free log retrieval operation binding the contract event 0xf6a97944f31ea060dfde0566e4167c1a1082551e64b60ecb14d599a9d023d451.
+//
+// Solidity: event NewTransmission(uint32 indexed aggregatorRoundId, int192 answer, address transmitter, int192[] observations, bytes observers, bytes32 rawReportContext)
+func (_ChainlinkOCR *ChainlinkOCRFilterer) FilterNewTransmission(opts *bind.FilterOpts, aggregatorRoundId []uint32) (*ChainlinkOCRNewTransmissionIterator, error) {
+
+	var aggregatorRoundIdRule []interface{}
+	for _, aggregatorRoundIdItem := range aggregatorRoundId {
+		aggregatorRoundIdRule = append(aggregatorRoundIdRule, aggregatorRoundIdItem)
+	}
+
+	logs, sub, err := _ChainlinkOCR.contract.FilterLogs(opts, "NewTransmission", aggregatorRoundIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ChainlinkOCRNewTransmissionIterator{contract: _ChainlinkOCR.contract, event: "NewTransmission", logs: logs, sub: sub}, nil
+}
+
+// WatchNewTransmission is a free log subscription operation binding the contract event 0xf6a97944f31ea060dfde0566e4167c1a1082551e64b60ecb14d599a9d023d451.
+//
+// Solidity: event NewTransmission(uint32 indexed aggregatorRoundId, int192 answer, address transmitter, int192[] observations, bytes observers, bytes32 rawReportContext)
+func (_ChainlinkOCR *ChainlinkOCRFilterer) WatchNewTransmission(opts *bind.WatchOpts, sink chan<- *ChainlinkOCRNewTransmission, aggregatorRoundId []uint32) (event.Subscription, error) {
+
+	var aggregatorRoundIdRule []interface{}
+	for _, aggregatorRoundIdItem := range aggregatorRoundId {
+		aggregatorRoundIdRule = append(aggregatorRoundIdRule, aggregatorRoundIdItem)
+	}
+
+	logs, sub, err := _ChainlinkOCR.contract.WatchLogs(opts, "NewTransmission", aggregatorRoundIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ChainlinkOCRNewTransmission)
+				if err := _ChainlinkOCR.contract.UnpackLog(event, "NewTransmission", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseNewTransmission is a log parse operation binding the contract event 0xf6a97944f31ea060dfde0566e4167c1a1082551e64b60ecb14d599a9d023d451.
+//
+// Solidity: event NewTransmission(uint32 indexed aggregatorRoundId, int192 answer, address transmitter, int192[] observations, bytes observers, bytes32 rawReportContext)
+func (_ChainlinkOCR *ChainlinkOCRFilterer) ParseNewTransmission(log types.Log) (*ChainlinkOCRNewTransmission, error) {
+	event := new(ChainlinkOCRNewTransmission)
+	if err := _ChainlinkOCR.contract.UnpackLog(event, "NewTransmission", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
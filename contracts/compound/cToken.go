@@ -0,0 +1,455 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package compound
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// CTokenABI is the input ABI used to generate the binding from.
+const CTokenABI = "[{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"mintAmount\",\"type\":\"uint256\"}],\"name\":\"mint\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"mint\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"redeemTokens\",\"type\":\"uint256\"}],\"name\":\"redeem\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"redeemAmount\",\"type\":\"uint256\"}],\"name\":\"redeemUnderlying\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"borrowAmount\",\"type\":\"uint256\"}],\"name\":\"borrow\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"repayAmount\",\"type\":\"uint256\"}],\"name\":\"repayBorrow\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"repayBorrow\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"borrower\",\"type\":\"address\"}],\"name\":\"repayBorrowBehalf\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"borrower\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"repayAmount\",\"type\":\"uint256\"}],\"name\":\"repayBorrowBehalf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"borrower\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"repayAmount\",\"type\":\"uint256\"},{\"internalType\":\"contractCTokenInterface\",\"name\":\"cTokenCollateral\",\"type\":\"address\"}],\"name\":\"liquidateBorrow\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"borrower\",\"type\":\"address\"},{\"internalType\":\"contractCToken\",\"name\":\"cTokenCollateral\",\"type\":\"address\"}],\"name\":\"liquidateBorrow\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"underlying\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]"
+
+// CToken is an auto generated Go binding around an Ethereum contract.
+type CToken struct {
+	CTokenCaller     // Read-only binding to the contract
+	CTokenTransactor // Write-only binding to the contract
+	CTokenFilterer   // Log filterer for contract events
+}
+
+// CTokenCaller is an auto generated read-only Go binding around an Ethereum contract.
+type CTokenCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CTokenTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type CTokenTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CTokenFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type CTokenFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CTokenSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type CTokenSession struct {
+	Contract     *CToken           // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// CTokenCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type CTokenCallerSession struct {
+	Contract *CTokenCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// CTokenTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type CTokenTransactorSession struct {
+	Contract     *CTokenTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// CTokenRaw is an auto generated low-level Go binding around an Ethereum contract.
+type CTokenRaw struct {
+	Contract *CToken // Generic contract binding to access the raw methods on
+}
+
+// CTokenCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type CTokenCallerRaw struct {
+	Contract *CTokenCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// CTokenTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type CTokenTransactorRaw struct {
+	Contract *CTokenTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewCToken creates a new instance of CToken, bound to a specific deployed contract.
+func NewCToken(address common.Address, backend bind.ContractBackend) (*CToken, error) {
+	contract, err := bindCToken(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &CToken{CTokenCaller: CTokenCaller{contract: contract}, CTokenTransactor: CTokenTransactor{contract: contract}, CTokenFilterer: CTokenFilterer{contract: contract}}, nil
+}
+
+// NewCTokenCaller creates a new read-only instance of CToken, bound to a specific deployed contract.
+func NewCTokenCaller(address common.Address, caller bind.ContractCaller) (*CTokenCaller, error) {
+	contract, err := bindCToken(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CTokenCaller{contract: contract}, nil
+}
+
+// NewCTokenTransactor creates a new write-only instance of CToken, bound to a specific deployed contract.
+func NewCTokenTransactor(address common.Address, transactor bind.ContractTransactor) (*CTokenTransactor, error) {
+	contract, err := bindCToken(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CTokenTransactor{contract: contract}, nil
+}
+
+// NewCTokenFilterer creates a new log filterer instance of CToken, bound to a specific deployed contract.
+func NewCTokenFilterer(address common.Address, filterer bind.ContractFilterer) (*CTokenFilterer, error) {
+	contract, err := bindCToken(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &CTokenFilterer{contract: contract}, nil
+}
+
+// bindCToken binds a generic wrapper to an already deployed contract.
+func bindCToken(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(CTokenABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_CToken *CTokenRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _CToken.Contract.CTokenCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_CToken *CTokenRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CToken.Contract.CTokenTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_CToken *CTokenRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _CToken.Contract.CTokenTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_CToken *CTokenCallerRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _CToken.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_CToken *CTokenTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CToken.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_CToken *CTokenTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _CToken.Contract.contract.Transact(opts, method, params...)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_CToken *CTokenCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var (
+		ret0 = new(string)
+	)
+	out := ret0
+	err := _CToken.contract.Call(opts, out, "symbol")
+	return *ret0, err
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_CToken *CTokenSession) Symbol() (string, error) {
+	return _CToken.Contract.Symbol(&_CToken.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_CToken *CTokenCallerSession) Symbol() (string, error) {
+	return _CToken.Contract.Symbol(&_CToken.CallOpts)
+}
+
+// Underlying is a free data retrieval call binding the contract method 0x6f307dc3.
+//
+// Solidity: function underlying() view returns(address)
+func (_CToken *CTokenCaller) Underlying(opts *bind.CallOpts) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _CToken.contract.Call(opts, out, "underlying")
+	return *ret0, err
+}
+
+// Underlying is a free data retrieval call binding the contract method 0x6f307dc3.
+//
+// Solidity: function underlying() view returns(address)
+func (_CToken *CTokenSession) Underlying() (common.Address, error) {
+	return _CToken.Contract.Underlying(&_CToken.CallOpts)
+}
+
+// Underlying is a free data retrieval call binding the contract method 0x6f307dc3.
+//
+// Solidity: function underlying() view returns(address)
+func (_CToken *CTokenCallerSession) Underlying() (common.Address, error) {
+	return _CToken.Contract.Underlying(&_CToken.CallOpts)
+}
+
+// Borrow is a paid mutator transaction binding the contract method 0xc5ebeaec.
+//
+// Solidity: function borrow(uint256 borrowAmount) returns(uint256)
+func (_CToken *CTokenTransactor) Borrow(opts *bind.TransactOpts, borrowAmount *big.Int) (*types.Transaction, error) {
+	return _CToken.contract.Transact(opts, "borrow", borrowAmount)
+}
+
+// Borrow is a paid mutator transaction binding the contract method 0xc5ebeaec.
+//
+// Solidity: function borrow(uint256 borrowAmount) returns(uint256)
+func (_CToken *CTokenSession) Borrow(borrowAmount *big.Int) (*types.Transaction, error) {
+	return _CToken.Contract.Borrow(&_CToken.TransactOpts, borrowAmount)
+}
+
+// Borrow is a paid mutator transaction binding the contract method 0xc5ebeaec.
+//
+// Solidity: function borrow(uint256 borrowAmount) returns(uint256)
+func (_CToken *CTokenTransactorSession) Borrow(borrowAmount *big.Int) (*types.Transaction, error) {
+	return _CToken.Contract.Borrow(&_CToken.TransactOpts, borrowAmount)
+}
+
+// LiquidateBorrow is a paid mutator transaction binding the contract method 0xf5e3c462.
+//
+// Solidity: function liquidateBorrow(address borrower, uint256 repayAmount, address cTokenCollateral) returns(uint256)
+func (_CToken *CTokenTransactor) LiquidateBorrow(opts *bind.TransactOpts, borrower common.Address, repayAmount *big.Int, cTokenCollateral common.Address) (*types.Transaction, error) {
+	return _CToken.contract.Transact(opts, "liquidateBorrow", borrower, repayAmount, cTokenCollateral)
+}
+
+// LiquidateBorrow is a paid mutator transaction binding the contract method 0xf5e3c462.
+//
+// Solidity: function liquidateBorrow(address borrower, uint256 repayAmount, address cTokenCollateral) returns(uint256)
+func (_CToken *CTokenSession) LiquidateBorrow(borrower common.Address, repayAmount *big.Int, cTokenCollateral common.Address) (*types.Transaction, error) {
+	return _CToken.Contract.LiquidateBorrow(&_CToken.TransactOpts, borrower, repayAmount, cTokenCollateral)
+}
+
+// LiquidateBorrow is a paid mutator transaction binding the contract method 0xf5e3c462.
+//
+// Solidity: function liquidateBorrow(address borrower, uint256 repayAmount, address cTokenCollateral) returns(uint256)
+func (_CToken *CTokenTransactorSession) LiquidateBorrow(borrower common.Address, repayAmount *big.Int, cTokenCollateral common.Address) (*types.Transaction, error) {
+	return _CToken.Contract.LiquidateBorrow(&_CToken.TransactOpts, borrower, repayAmount, cTokenCollateral)
+}
+
+// LiquidateBorrow0 is a paid mutator transaction binding the contract method 0xaae40a2a.
+//
+// Solidity: function liquidateBorrow(address borrower, address cTokenCollateral) payable returns()
+func (_CToken *CTokenTransactor) LiquidateBorrow0(opts *bind.TransactOpts, borrower common.Address, cTokenCollateral common.Address) (*types.Transaction, error) {
+	return _CToken.contract.Transact(opts, "liquidateBorrow0", borrower, cTokenCollateral)
+}
+
+// LiquidateBorrow0 is a paid mutator transaction binding the contract method 0xaae40a2a.
+//
+// Solidity: function liquidateBorrow(address borrower, address cTokenCollateral) payable returns()
+func (_CToken *CTokenSession) LiquidateBorrow0(borrower common.Address, cTokenCollateral common.Address) (*types.Transaction, error) {
+	return _CToken.Contract.LiquidateBorrow0(&_CToken.TransactOpts, borrower, cTokenCollateral)
+}
+
+// LiquidateBorrow0 is a paid mutator transaction binding the contract method 0xaae40a2a.
+//
+// Solidity: function liquidateBorrow(address borrower, address cTokenCollateral) payable returns()
+func (_CToken *CTokenTransactorSession) LiquidateBorrow0(borrower common.Address, cTokenCollateral common.Address) (*types.Transaction, error) {
+	return _CToken.Contract.LiquidateBorrow0(&_CToken.TransactOpts, borrower, cTokenCollateral)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0xa0712d68.
+//
+// Solidity: function mint(uint256 mintAmount) returns(uint256)
+func (_CToken *CTokenTransactor) Mint(opts *bind.TransactOpts, mintAmount *big.Int) (*types.Transaction, error) {
+	return _CToken.contract.Transact(opts, "mint", mintAmount)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0xa0712d68.
+//
+// Solidity: function mint(uint256 mintAmount) returns(uint256)
+func (_CToken *CTokenSession) Mint(mintAmount *big.Int) (*types.Transaction, error) {
+	return _CToken.Contract.Mint(&_CToken.TransactOpts, mintAmount)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0xa0712d68.
+//
+// Solidity: function mint(uint256 mintAmount) returns(uint256)
+func (_CToken *CTokenTransactorSession) Mint(mintAmount *big.Int) (*types.Transaction, error) {
+	return _CToken.Contract.Mint(&_CToken.TransactOpts, mintAmount)
+}
+
+// Mint0 is a paid mutator transaction binding the contract method 0x1249c58b.
+//
+// Solidity: function mint() payable returns()
+func (_CToken *CTokenTransactor) Mint0(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CToken.contract.Transact(opts, "mint0")
+}
+
+// Mint0 is a paid mutator transaction binding the contract method 0x1249c58b.
+//
+// Solidity: function mint() payable returns()
+func (_CToken *CTokenSession) Mint0() (*types.Transaction, error) {
+	return _CToken.Contract.Mint0(&_CToken.TransactOpts)
+}
+
+// Mint0 is a paid mutator transaction binding the contract method 0x1249c58b.
+//
+// Solidity: function mint() payable returns()
+func (_CToken *CTokenTransactorSession) Mint0() (*types.Transaction, error) {
+	return _CToken.Contract.Mint0(&_CToken.TransactOpts)
+}
+
+// Redeem is a paid mutator transaction binding the contract method 0xdb006a75.
+//
+// Solidity: function redeem(uint256 redeemTokens) returns(uint256)
+func (_CToken *CTokenTransactor) Redeem(opts *bind.TransactOpts, redeemTokens *big.Int) (*types.Transaction, error) {
+	return _CToken.contract.Transact(opts, "redeem", redeemTokens)
+}
+
+// Redeem is a paid mutator transaction binding the contract method 0xdb006a75.
+//
+// Solidity: function redeem(uint256 redeemTokens) returns(uint256)
+func (_CToken *CTokenSession) Redeem(redeemTokens *big.Int) (*types.Transaction, error) {
+	return _CToken.Contract.Redeem(&_CToken.TransactOpts, redeemTokens)
+}
+
+// Redeem is a paid mutator transaction binding the contract method 0xdb006a75.
+//
+// Solidity: function redeem(uint256 redeemTokens) returns(uint256)
+func (_CToken *CTokenTransactorSession) Redeem(redeemTokens *big.Int) (*types.Transaction, error) {
+	return _CToken.Contract.Redeem(&_CToken.TransactOpts, redeemTokens)
+}
+
+// RedeemUnderlying is a paid mutator transaction binding the contract method 0x852a12e3.
+//
+// Solidity: function redeemUnderlying(uint256 redeemAmount) returns(uint256)
+func (_CToken *CTokenTransactor) RedeemUnderlying(opts *bind.TransactOpts, redeemAmount *big.Int) (*types.Transaction, error) {
+	return _CToken.contract.Transact(opts, "redeemUnderlying", redeemAmount)
+}
+
+// RedeemUnderlying is a paid mutator transaction binding the contract method 0x852a12e3.
+//
+// Solidity: function redeemUnderlying(uint256 redeemAmount) returns(uint256)
+func (_CToken *CTokenSession) RedeemUnderlying(redeemAmount *big.Int) (*types.Transaction, error) {
+	return _CToken.Contract.RedeemUnderlying(&_CToken.TransactOpts, redeemAmount)
+}
+
+// RedeemUnderlying is a paid mutator transaction binding the contract method 0x852a12e3.
+//
+// Solidity: function redeemUnderlying(uint256 redeemAmount) returns(uint256)
+func (_CToken *CTokenTransactorSession) RedeemUnderlying(redeemAmount *big.Int) (*types.Transaction, error) {
+	return _CToken.Contract.RedeemUnderlying(&_CToken.TransactOpts, redeemAmount)
+}
+
+// RepayBorrow is a paid mutator transaction binding the contract method 0x0e752702.
+//
+// Solidity: function repayBorrow(uint256 repayAmount) returns(uint256)
+func (_CToken *CTokenTransactor) RepayBorrow(opts *bind.TransactOpts, repayAmount *big.Int) (*types.Transaction, error) {
+	return _CToken.contract.Transact(opts, "repayBorrow", repayAmount)
+}
+
+// RepayBorrow is a paid mutator transaction binding the contract method 0x0e752702.
+//
+// Solidity: function repayBorrow(uint256 repayAmount) returns(uint256)
+func (_CToken *CTokenSession) RepayBorrow(repayAmount *big.Int) (*types.Transaction, error) {
+	return _CToken.Contract.RepayBorrow(&_CToken.TransactOpts, repayAmount)
+}
+
+// RepayBorrow is a paid mutator transaction binding the contract method 0x0e752702.
+//
+// Solidity: function repayBorrow(uint256 repayAmount) returns(uint256)
+func (_CToken *CTokenTransactorSession) RepayBorrow(repayAmount *big.Int) (*types.Transaction, error) {
+	return _CToken.Contract.RepayBorrow(&_CToken.TransactOpts, repayAmount)
+}
+
+// RepayBorrow0 is a paid mutator transaction binding the contract method 0x4e4d9fea.
+//
+// Solidity: function repayBorrow() payable returns()
+func (_CToken *CTokenTransactor) RepayBorrow0(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CToken.contract.Transact(opts, "repayBorrow0")
+}
+
+// RepayBorrow0 is a paid mutator transaction binding the contract method 0x4e4d9fea.
+//
+// Solidity: function repayBorrow() payable returns()
+func (_CToken *CTokenSession) RepayBorrow0() (*types.Transaction, error) {
+	return _CToken.Contract.RepayBorrow0(&_CToken.TransactOpts)
+}
+
+// RepayBorrow0 is a paid mutator transaction binding the contract method 0x4e4d9fea.
+//
+// Solidity: function repayBorrow() payable returns()
+func (_CToken *CTokenTransactorSession) RepayBorrow0() (*types.Transaction, error) {
+	return _CToken.Contract.RepayBorrow0(&_CToken.TransactOpts)
+}
+
+// RepayBorrowBehalf is a paid mutator transaction binding the contract method 0xe5974619.
+//
+// Solidity: function repayBorrowBehalf(address borrower) payable returns()
+func (_CToken *CTokenTransactor) RepayBorrowBehalf(opts *bind.TransactOpts, borrower common.Address) (*types.Transaction, error) {
+	return _CToken.contract.Transact(opts, "repayBorrowBehalf", borrower)
+}
+
+// RepayBorrowBehalf is a paid mutator transaction binding the contract method 0xe5974619.
+//
+// Solidity: function repayBorrowBehalf(address borrower) payable returns()
+func (_CToken *CTokenSession) RepayBorrowBehalf(borrower common.Address) (*types.Transaction, error) {
+	return _CToken.Contract.RepayBorrowBehalf(&_CToken.TransactOpts, borrower)
+}
+
+// RepayBorrowBehalf is a paid mutator transaction binding the contract method 0xe5974619.
+//
+// Solidity: function repayBorrowBehalf(address borrower) payable returns()
+func (_CToken *CTokenTransactorSession) RepayBorrowBehalf(borrower common.Address) (*types.Transaction, error) {
+	return _CToken.Contract.RepayBorrowBehalf(&_CToken.TransactOpts, borrower)
+}
+
+// RepayBorrowBehalf0 is a paid mutator transaction binding the contract method 0x2608f818.
+//
+// Solidity: function repayBorrowBehalf(address borrower, uint256 repayAmount) returns(uint256)
+func (_CToken *CTokenTransactor) RepayBorrowBehalf0(opts *bind.TransactOpts, borrower common.Address, repayAmount *big.Int) (*types.Transaction, error) {
+	return _CToken.contract.Transact(opts, "repayBorrowBehalf0", borrower, repayAmount)
+}
+
+// RepayBorrowBehalf0 is a paid mutator transaction binding the contract method 0x2608f818.
+//
+// Solidity: function repayBorrowBehalf(address borrower, uint256 repayAmount) returns(uint256)
+func (_CToken *CTokenSession) RepayBorrowBehalf0(borrower common.Address, repayAmount *big.Int) (*types.Transaction, error) {
+	return _CToken.Contract.RepayBorrowBehalf0(&_CToken.TransactOpts, borrower, repayAmount)
+}
+
+// RepayBorrowBehalf0 is a paid mutator transaction binding the contract method 0x2608f818.
+//
+// Solidity: function repayBorrowBehalf(address borrower, uint256 repayAmount) returns(uint256)
+func (_CToken *CTokenTransactorSession) RepayBorrowBehalf0(borrower common.Address, repayAmount *big.Int) (*types.Transaction, error) {
+	return _CToken.Contract.RepayBorrowBehalf0(&_CToken.TransactOpts, borrower, repayAmount)
+}
@@ -0,0 +1,282 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package compound
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// ComptrollerABI is the input ABI used to generate the binding from.
+const ComptrollerABI = "[{\"inputs\":[],\"name\":\"getAllMarkets\",\"outputs\":[{\"internalType\":\"contractCToken[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"cTokens\",\"type\":\"address[]\"}],\"name\":\"enterMarkets\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"cTokenAddress\",\"type\":\"address\"}],\"name\":\"exitMarket\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"holder\",\"type\":\"address\"}],\"name\":\"claimComp\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"holder\",\"type\":\"address\"},{\"internalType\":\"contractCToken[]\",\"name\":\"cTokens\",\"type\":\"address[]\"}],\"name\":\"claimComp\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+
+// Comptroller is an auto generated Go binding around an Ethereum contract.
+type Comptroller struct {
+	ComptrollerCaller     // Read-only binding to the contract
+	ComptrollerTransactor // Write-only binding to the contract
+	ComptrollerFilterer   // Log filterer for contract events
+}
+
+// ComptrollerCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ComptrollerCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ComptrollerTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ComptrollerTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ComptrollerFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ComptrollerFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ComptrollerSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ComptrollerSession struct {
+	Contract     *Comptroller      // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ComptrollerCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ComptrollerCallerSession struct {
+	Contract *ComptrollerCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts      // Call options to use throughout this session
+}
+
+// ComptrollerTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ComptrollerTransactorSession struct {
+	Contract     *ComptrollerTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts      // Transaction auth options to use throughout this session
+}
+
+// ComptrollerRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ComptrollerRaw struct {
+	Contract *Comptroller // Generic contract binding to access the raw methods on
+}
+
+// ComptrollerCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ComptrollerCallerRaw struct {
+	Contract *ComptrollerCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ComptrollerTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ComptrollerTransactorRaw struct {
+	Contract *ComptrollerTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewComptroller creates a new instance of Comptroller, bound to a specific deployed contract.
+func NewComptroller(address common.Address, backend bind.ContractBackend) (*Comptroller, error) {
+	contract, err := bindComptroller(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Comptroller{ComptrollerCaller: ComptrollerCaller{contract: contract}, ComptrollerTransactor: ComptrollerTransactor{contract: contract}, ComptrollerFilterer: ComptrollerFilterer{contract: contract}}, nil
+}
+
+// NewComptrollerCaller creates a new read-only instance of Comptroller, bound to a specific deployed contract.
+func NewComptrollerCaller(address common.Address, caller bind.ContractCaller) (*ComptrollerCaller, error) {
+	contract, err := bindComptroller(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ComptrollerCaller{contract: contract}, nil
+}
+
+// NewComptrollerTransactor creates a new write-only instance of Comptroller, bound to a specific deployed contract.
+func NewComptrollerTransactor(address common.Address, transactor bind.ContractTransactor) (*ComptrollerTransactor, error) {
+	contract, err := bindComptroller(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ComptrollerTransactor{contract: contract}, nil
+}
+
+// NewComptrollerFilterer creates a new log filterer instance of Comptroller, bound to a specific deployed contract.
+func NewComptrollerFilterer(address common.Address, filterer bind.ContractFilterer) (*ComptrollerFilterer, error) {
+	contract, err := bindComptroller(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ComptrollerFilterer{contract: contract}, nil
+}
+
+// bindComptroller binds a generic wrapper to an already deployed contract.
+func bindComptroller(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(ComptrollerABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Comptroller *ComptrollerRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _Comptroller.Contract.ComptrollerCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Comptroller *ComptrollerRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Comptroller.Contract.ComptrollerTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Comptroller *ComptrollerRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Comptroller.Contract.ComptrollerTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Comptroller *ComptrollerCallerRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _Comptroller.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Comptroller *ComptrollerTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Comptroller.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Comptroller *ComptrollerTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Comptroller.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetAllMarkets is a free data retrieval call binding the contract method 0xb0772d0b.
+//
+// Solidity: function getAllMarkets() view returns(address[])
+func (_Comptroller *ComptrollerCaller) GetAllMarkets(opts *bind.CallOpts) ([]common.Address, error) {
+	var (
+		ret0 = new([]common.Address)
+	)
+	out := ret0
+	err := _Comptroller.contract.Call(opts, out, "getAllMarkets")
+	return *ret0, err
+}
+
+// GetAllMarkets is a free data retrieval call binding the contract method 0xb0772d0b.
+//
+// Solidity: function getAllMarkets() view returns(address[])
+func (_Comptroller *ComptrollerSession) GetAllMarkets() ([]common.Address, error) {
+	return _Comptroller.Contract.GetAllMarkets(&_Comptroller.CallOpts)
+}
+
+// GetAllMarkets is a free data retrieval call binding the contract method 0xb0772d0b.
+//
+// Solidity: function getAllMarkets() view returns(address[])
+func (_Comptroller *ComptrollerCallerSession) GetAllMarkets() ([]common.Address, error) {
+	return _Comptroller.Contract.GetAllMarkets(&_Comptroller.CallOpts)
+}
+
+// EnterMarkets is a paid mutator transaction binding the contract method 0xc2998238.
+//
+// Solidity: function enterMarkets(address[] cTokens) returns(uint256[])
+func (_Comptroller *ComptrollerTransactor) EnterMarkets(opts *bind.TransactOpts, cTokens []common.Address) (*types.Transaction, error) {
+	return _Comptroller.contract.Transact(opts, "enterMarkets", cTokens)
+}
+
+// EnterMarkets is a paid mutator transaction binding the contract method 0xc2998238.
+//
+// Solidity: function enterMarkets(address[] cTokens) returns(uint256[])
+func (_Comptroller *ComptrollerSession) EnterMarkets(cTokens []common.Address) (*types.Transaction, error) {
+	return _Comptroller.Contract.EnterMarkets(&_Comptroller.TransactOpts, cTokens)
+}
+
+// EnterMarkets is a paid mutator transaction binding the contract method 0xc2998238.
+//
+// Solidity: function enterMarkets(address[] cTokens) returns(uint256[])
+func (_Comptroller *ComptrollerTransactorSession) EnterMarkets(cTokens []common.Address) (*types.Transaction, error) {
+	return _Comptroller.Contract.EnterMarkets(&_Comptroller.TransactOpts, cTokens)
+}
+
+// ExitMarket is a paid mutator transaction binding the contract method 0xede4edd0.
+//
+// Solidity: function exitMarket(address cTokenAddress) returns(uint256)
+func (_Comptroller *ComptrollerTransactor) ExitMarket(opts *bind.TransactOpts, cTokenAddress common.Address) (*types.Transaction, error) {
+	return _Comptroller.contract.Transact(opts, "exitMarket", cTokenAddress)
+}
+
+// ExitMarket is a paid mutator transaction binding the contract method 0xede4edd0.
+//
+// Solidity: function exitMarket(address cTokenAddress) returns(uint256)
+func (_Comptroller *ComptrollerSession) ExitMarket(cTokenAddress common.Address) (*types.Transaction, error) {
+	return _Comptroller.Contract.ExitMarket(&_Comptroller.TransactOpts, cTokenAddress)
+}
+
+// ExitMarket is a paid mutator transaction binding the contract method 0xede4edd0.
+//
+// Solidity: function exitMarket(address cTokenAddress) returns(uint256)
+func (_Comptroller *ComptrollerTransactorSession) ExitMarket(cTokenAddress common.Address) (*types.Transaction, error) {
+	return _Comptroller.Contract.ExitMarket(&_Comptroller.TransactOpts, cTokenAddress)
+}
+
+// ClaimComp is a paid mutator transaction binding the contract method 0xe9af0292.
+//
+// Solidity: function claimComp(address holder) returns()
+func (_Comptroller *ComptrollerTransactor) ClaimComp(opts *bind.TransactOpts, holder common.Address) (*types.Transaction, error) {
+	return _Comptroller.contract.Transact(opts, "claimComp", holder)
+}
+
+// ClaimComp is a paid mutator transaction binding the contract method 0xe9af0292.
+//
+// Solidity: function claimComp(address holder) returns()
+func (_Comptroller *ComptrollerSession) ClaimComp(holder common.Address) (*types.Transaction, error) {
+	return _Comptroller.Contract.ClaimComp(&_Comptroller.TransactOpts, holder)
+}
+
+// ClaimComp is a paid mutator transaction binding the contract method 0xe9af0292.
+//
+// Solidity: function claimComp(address holder) returns()
+func (_Comptroller *ComptrollerTransactorSession) ClaimComp(holder common.Address) (*types.Transaction, error) {
+	return _Comptroller.Contract.ClaimComp(&_Comptroller.TransactOpts, holder)
+}
+
+// ClaimComp0 is a paid mutator transaction binding the contract method 0x1c3db2e0.
+//
+// Solidity: function claimComp(address holder, address[] cTokens) returns()
+func (_Comptroller *ComptrollerTransactor) ClaimComp0(opts *bind.TransactOpts, holder common.Address, cTokens []common.Address) (*types.Transaction, error) {
+	return _Comptroller.contract.Transact(opts, "claimComp0", holder, cTokens)
+}
+
+// ClaimComp0 is a paid mutator transaction binding the contract method 0x1c3db2e0.
+//
+// Solidity: function claimComp(address holder, address[] cTokens) returns()
+func (_Comptroller *ComptrollerSession) ClaimComp0(holder common.Address, cTokens []common.Address) (*types.Transaction, error) {
+	return _Comptroller.Contract.ClaimComp0(&_Comptroller.TransactOpts, holder, cTokens)
+}
+
+// ClaimComp0 is a paid mutator transaction binding the contract method 0x1c3db2e0.
+//
+// Solidity: function claimComp(address holder, address[] cTokens) returns()
+func (_Comptroller *ComptrollerTransactorSession) ClaimComp0(holder common.Address, cTokens []common.Address) (*types.Transaction, error) {
+	return _Comptroller.Contract.ClaimComp0(&_Comptroller.TransactOpts, holder, cTokens)
+}
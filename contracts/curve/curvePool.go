@@ -0,0 +1,386 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package curve
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// CurvePoolABI is the input ABI used to generate the binding from.
+const CurvePoolABI = "[{\"inputs\":[{\"internalType\":\"int128\",\"name\":\"i\",\"type\":\"int128\"},{\"internalType\":\"int128\",\"name\":\"j\",\"type\":\"int128\"},{\"internalType\":\"uint256\",\"name\":\"dx\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"min_dy\",\"type\":\"uint256\"}],\"name\":\"exchange\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"int128\",\"name\":\"i\",\"type\":\"int128\"},{\"internalType\":\"int128\",\"name\":\"j\",\"type\":\"int128\"},{\"internalType\":\"uint256\",\"name\":\"dx\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"min_dy\",\"type\":\"uint256\"}],\"name\":\"exchange_underlying\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"i\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"j\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"dx\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"min_dy\",\"type\":\"uint256\"}],\"name\":\"exchange\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"i\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"j\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"dx\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"min_dy\",\"type\":\"uint256\"}],\"name\":\"exchange_underlying\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"arg0\",\"type\":\"uint256\"}],\"name\":\"coins\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"arg0\",\"type\":\"uint256\"}],\"name\":\"underlying_coins\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"int128\",\"name\":\"arg0\",\"type\":\"int128\"}],\"name\":\"coins\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"int128\",\"name\":\"arg0\",\"type\":\"int128\"}],\"name\":\"underlying_coins\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"arg0\",\"type\":\"uint256\"}],\"name\":\"base_coins\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]"
+
+// CurvePool is an auto generated Go binding around an Ethereum contract.
+type CurvePool struct {
+	CurvePoolCaller     // Read-only binding to the contract
+	CurvePoolTransactor // Write-only binding to the contract
+	CurvePoolFilterer   // Log filterer for contract events
+}
+
+// CurvePoolCaller is an auto generated read-only Go binding around an Ethereum contract.
+type CurvePoolCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CurvePoolTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type CurvePoolTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CurvePoolFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type CurvePoolFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CurvePoolSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type CurvePoolSession struct {
+	Contract     *CurvePool        // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// CurvePoolCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type CurvePoolCallerSession struct {
+	Contract *CurvePoolCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts    // Call options to use throughout this session
+}
+
+// CurvePoolTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type CurvePoolTransactorSession struct {
+	Contract     *CurvePoolTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// CurvePoolRaw is an auto generated low-level Go binding around an Ethereum contract.
+type CurvePoolRaw struct {
+	Contract *CurvePool // Generic contract binding to access the raw methods on
+}
+
+// CurvePoolCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type CurvePoolCallerRaw struct {
+	Contract *CurvePoolCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// CurvePoolTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type CurvePoolTransactorRaw struct {
+	Contract *CurvePoolTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewCurvePool creates a new instance of CurvePool, bound to a specific deployed contract.
+func NewCurvePool(address common.Address, backend bind.ContractBackend) (*CurvePool, error) {
+	contract, err := bindCurvePool(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &CurvePool{CurvePoolCaller: CurvePoolCaller{contract: contract}, CurvePoolTransactor: CurvePoolTransactor{contract: contract}, CurvePoolFilterer: CurvePoolFilterer{contract: contract}}, nil
+}
+
+// NewCurvePoolCaller creates a new read-only instance of CurvePool, bound to a specific deployed contract.
+func NewCurvePoolCaller(address common.Address, caller bind.ContractCaller) (*CurvePoolCaller, error) {
+	contract, err := bindCurvePool(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CurvePoolCaller{contract: contract}, nil
+}
+
+// NewCurvePoolTransactor creates a new write-only instance of CurvePool, bound to a specific deployed contract.
+func NewCurvePoolTransactor(address common.Address, transactor bind.ContractTransactor) (*CurvePoolTransactor, error) {
+	contract, err := bindCurvePool(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CurvePoolTransactor{contract: contract}, nil
+}
+
+// NewCurvePoolFilterer creates a new log filterer instance of CurvePool, bound to a specific deployed contract.
+func NewCurvePoolFilterer(address common.Address, filterer bind.ContractFilterer) (*CurvePoolFilterer, error) {
+	contract, err := bindCurvePool(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &CurvePoolFilterer{contract: contract}, nil
+}
+
+// bindCurvePool binds a generic wrapper to an already deployed contract.
+func bindCurvePool(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(CurvePoolABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_CurvePool *CurvePoolRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _CurvePool.Contract.CurvePoolCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_CurvePool *CurvePoolRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CurvePool.Contract.CurvePoolTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_CurvePool *CurvePoolRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _CurvePool.Contract.CurvePoolTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_CurvePool *CurvePoolCallerRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _CurvePool.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_CurvePool *CurvePoolTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CurvePool.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_CurvePool *CurvePoolTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _CurvePool.Contract.contract.Transact(opts, method, params...)
+}
+
+// BaseCoins is a free data retrieval call binding the contract method 0x87cb4f57.
+//
+// Solidity: function base_coins(uint256 arg0) view returns(address)
+func (_CurvePool *CurvePoolCaller) BaseCoins(opts *bind.CallOpts, arg0 *big.Int) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _CurvePool.contract.Call(opts, out, "base_coins", arg0)
+	return *ret0, err
+}
+
+// BaseCoins is a free data retrieval call binding the contract method 0x87cb4f57.
+//
+// Solidity: function base_coins(uint256 arg0) view returns(address)
+func (_CurvePool *CurvePoolSession) BaseCoins(arg0 *big.Int) (common.Address, error) {
+	return _CurvePool.Contract.BaseCoins(&_CurvePool.CallOpts, arg0)
+}
+
+// BaseCoins is a free data retrieval call binding the contract method 0x87cb4f57.
+//
+// Solidity: function base_coins(uint256 arg0) view returns(address)
+func (_CurvePool *CurvePoolCallerSession) BaseCoins(arg0 *big.Int) (common.Address, error) {
+	return _CurvePool.Contract.BaseCoins(&_CurvePool.CallOpts, arg0)
+}
+
+// Coins is a free data retrieval call binding the contract method 0xc6610657.
+//
+// Solidity: function coins(uint256 arg0) view returns(address)
+func (_CurvePool *CurvePoolCaller) Coins(opts *bind.CallOpts, arg0 *big.Int) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _CurvePool.contract.Call(opts, out, "coins", arg0)
+	return *ret0, err
+}
+
+// Coins is a free data retrieval call binding the contract method 0xc6610657.
+//
+// Solidity: function coins(uint256 arg0) view returns(address)
+func (_CurvePool *CurvePoolSession) Coins(arg0 *big.Int) (common.Address, error) {
+	return _CurvePool.Contract.Coins(&_CurvePool.CallOpts, arg0)
+}
+
+// Coins is a free data retrieval call binding the contract method 0xc6610657.
+//
+// Solidity: function coins(uint256 arg0) view returns(address)
+func (_CurvePool *CurvePoolCallerSession) Coins(arg0 *big.Int) (common.Address, error) {
+	return _CurvePool.Contract.Coins(&_CurvePool.CallOpts, arg0)
+}
+
+// Coins0 is a free data retrieval call binding the contract method 0x23746eb8.
+//
+// Solidity: function coins(int128 arg0) view returns(address)
+func (_CurvePool *CurvePoolCaller) Coins0(opts *bind.CallOpts, arg0 *big.Int) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _CurvePool.contract.Call(opts, out, "coins0", arg0)
+	return *ret0, err
+}
+
+// Coins0 is a free data retrieval call binding the contract method 0x23746eb8.
+//
+// Solidity: function coins(int128 arg0) view returns(address)
+func (_CurvePool *CurvePoolSession) Coins0(arg0 *big.Int) (common.Address, error) {
+	return _CurvePool.Contract.Coins0(&_CurvePool.CallOpts, arg0)
+}
+
+// Coins0 is a free data retrieval call binding the contract method 0x23746eb8.
+//
+// Solidity: function coins(int128 arg0) view returns(address)
+func (_CurvePool *CurvePoolCallerSession) Coins0(arg0 *big.Int) (common.Address, error) {
+	return _CurvePool.Contract.Coins0(&_CurvePool.CallOpts, arg0)
+}
+
+// UnderlyingCoins is a free data retrieval call binding the contract method 0xb9947eb0.
+//
+// Solidity: function underlying_coins(uint256 arg0) view returns(address)
+func (_CurvePool *CurvePoolCaller) UnderlyingCoins(opts *bind.CallOpts, arg0 *big.Int) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _CurvePool.contract.Call(opts, out, "underlying_coins", arg0)
+	return *ret0, err
+}
+
+// UnderlyingCoins is a free data retrieval call binding the contract method 0xb9947eb0.
+//
+// Solidity: function underlying_coins(uint256 arg0) view returns(address)
+func (_CurvePool *CurvePoolSession) UnderlyingCoins(arg0 *big.Int) (common.Address, error) {
+	return _CurvePool.Contract.UnderlyingCoins(&_CurvePool.CallOpts, arg0)
+}
+
+// UnderlyingCoins is a free data retrieval call binding the contract method 0xb9947eb0.
+//
+// Solidity: function underlying_coins(uint256 arg0) view returns(address)
+func (_CurvePool *CurvePoolCallerSession) UnderlyingCoins(arg0 *big.Int) (common.Address, error) {
+	return _CurvePool.Contract.UnderlyingCoins(&_CurvePool.CallOpts, arg0)
+}
+
+// UnderlyingCoins0 is a free data retrieval call binding the contract method 0xb739953e.
+//
+// Solidity: function underlying_coins(int128 arg0) view returns(address)
+func (_CurvePool *CurvePoolCaller) UnderlyingCoins0(opts *bind.CallOpts, arg0 *big.Int) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _CurvePool.contract.Call(opts, out, "underlying_coins0", arg0)
+	return *ret0, err
+}
+
+// UnderlyingCoins0 is a free data retrieval call binding the contract method 0xb739953e.
+//
+// Solidity: function underlying_coins(int128 arg0) view returns(address)
+func (_CurvePool *CurvePoolSession) UnderlyingCoins0(arg0 *big.Int) (common.Address, error) {
+	return _CurvePool.Contract.UnderlyingCoins0(&_CurvePool.CallOpts, arg0)
+}
+
+// UnderlyingCoins0 is a free data retrieval call binding the contract method 0xb739953e.
+//
+// Solidity: function underlying_coins(int128 arg0) view returns(address)
+func (_CurvePool *CurvePoolCallerSession) UnderlyingCoins0(arg0 *big.Int) (common.Address, error) {
+	return _CurvePool.Contract.UnderlyingCoins0(&_CurvePool.CallOpts, arg0)
+}
+
+// Exchange is a paid mutator transaction binding the contract method 0x3df02124.
+//
+// Solidity: function exchange(int128 i, int128 j, uint256 dx, uint256 min_dy) payable returns(uint256)
+func (_CurvePool *CurvePoolTransactor) Exchange(opts *bind.TransactOpts, i *big.Int, j *big.Int, dx *big.Int, min_dy *big.Int) (*types.Transaction, error) {
+	return _CurvePool.contract.Transact(opts, "exchange", i, j, dx, min_dy)
+}
+
+// Exchange is a paid mutator transaction binding the contract method 0x3df02124.
+//
+// Solidity: function exchange(int128 i, int128 j, uint256 dx, uint256 min_dy) payable returns(uint256)
+func (_CurvePool *CurvePoolSession) Exchange(i *big.Int, j *big.Int, dx *big.Int, min_dy *big.Int) (*types.Transaction, error) {
+	return _CurvePool.Contract.Exchange(&_CurvePool.TransactOpts, i, j, dx, min_dy)
+}
+
+// Exchange is a paid mutator transaction binding the contract method 0x3df02124.
+//
+// Solidity: function exchange(int128 i, int128 j, uint256 dx, uint256 min_dy) payable returns(uint256)
+func (_CurvePool *CurvePoolTransactorSession) Exchange(i *big.Int, j *big.Int, dx *big.Int, min_dy *big.Int) (*types.Transaction, error) {
+	return _CurvePool.Contract.Exchange(&_CurvePool.TransactOpts, i, j, dx, min_dy)
+}
+
+// Exchange0 is a paid mutator transaction binding the contract method 0x5b41b908.
+//
+// Solidity: function exchange(uint256 i, uint256 j, uint256 dx, uint256 min_dy) payable returns(uint256)
+func (_CurvePool *CurvePoolTransactor) Exchange0(opts *bind.TransactOpts, i *big.Int, j *big.Int, dx *big.Int, min_dy *big.Int) (*types.Transaction, error) {
+	return _CurvePool.contract.Transact(opts, "exchange0", i, j, dx, min_dy)
+}
+
+// Exchange0 is a paid mutator transaction binding the contract method 0x5b41b908.
+//
+// Solidity: function exchange(uint256 i, uint256 j, uint256 dx, uint256 min_dy) payable returns(uint256)
+func (_CurvePool *CurvePoolSession) Exchange0(i *big.Int, j *big.Int, dx *big.Int, min_dy *big.Int) (*types.Transaction, error) {
+	return _CurvePool.Contract.Exchange0(&_CurvePool.TransactOpts, i, j, dx, min_dy)
+}
+
+// Exchange0 is a paid mutator transaction binding the contract method 0x5b41b908.
+//
+// Solidity: function exchange(uint256 i, uint256 j, uint256 dx, uint256 min_dy) payable returns(uint256)
+func (_CurvePool *CurvePoolTransactorSession) Exchange0(i *big.Int, j *big.Int, dx *big.Int, min_dy *big.Int) (*types.Transaction, error) {
+	return _CurvePool.Contract.Exchange0(&_CurvePool.TransactOpts, i, j, dx, min_dy)
+}
+
+// ExchangeUnderlying is a paid mutator transaction binding the contract method 0xa6417ed6.
+//
+// Solidity: function exchange_underlying(int128 i, int128 j, uint256 dx, uint256 min_dy) payable returns(uint256)
+func (_CurvePool *CurvePoolTransactor) ExchangeUnderlying(opts *bind.TransactOpts, i *big.Int, j *big.Int, dx *big.Int, min_dy *big.Int) (*types.Transaction, error) {
+	return _CurvePool.contract.Transact(opts, "exchange_underlying", i, j, dx, min_dy)
+}
+
+// ExchangeUnderlying is a paid mutator transaction binding the contract method 0xa6417ed6.
+//
+// Solidity: function exchange_underlying(int128 i, int128 j, uint256 dx, uint256 min_dy) payable returns(uint256)
+func (_CurvePool *CurvePoolSession) ExchangeUnderlying(i *big.Int, j *big.Int, dx *big.Int, min_dy *big.Int) (*types.Transaction, error) {
+	return _CurvePool.Contract.ExchangeUnderlying(&_CurvePool.TransactOpts, i, j, dx, min_dy)
+}
+
+// ExchangeUnderlying is a paid mutator transaction binding the contract method 0xa6417ed6.
+//
+// Solidity: function exchange_underlying(int128 i, int128 j, uint256 dx, uint256 min_dy) payable returns(uint256)
+func (_CurvePool *CurvePoolTransactorSession) ExchangeUnderlying(i *big.Int, j *big.Int, dx *big.Int, min_dy *big.Int) (*types.Transaction, error) {
+	return _CurvePool.Contract.ExchangeUnderlying(&_CurvePool.TransactOpts, i, j, dx, min_dy)
+}
+
+// ExchangeUnderlying0 is a paid mutator transaction binding the contract method 0x65b2489b.
+//
+// Solidity: function exchange_underlying(uint256 i, uint256 j, uint256 dx, uint256 min_dy) payable returns(uint256)
+func (_CurvePool *CurvePoolTransactor) ExchangeUnderlying0(opts *bind.TransactOpts, i *big.Int, j *big.Int, dx *big.Int, min_dy *big.Int) (*types.Transaction, error) {
+	return _CurvePool.contract.Transact(opts, "exchange_underlying0", i, j, dx, min_dy)
+}
+
+// ExchangeUnderlying0 is a paid mutator transaction binding the contract method 0x65b2489b.
+//
+// Solidity: function exchange_underlying(uint256 i, uint256 j, uint256 dx, uint256 min_dy) payable returns(uint256)
+func (_CurvePool *CurvePoolSession) ExchangeUnderlying0(i *big.Int, j *big.Int, dx *big.Int, min_dy *big.Int) (*types.Transaction, error) {
+	return _CurvePool.Contract.ExchangeUnderlying0(&_CurvePool.TransactOpts, i, j, dx, min_dy)
+}
+
+// ExchangeUnderlying0 is a paid mutator transaction binding the contract method 0x65b2489b.
+//
+// Solidity: function exchange_underlying(uint256 i, uint256 j, uint256 dx, uint256 min_dy) payable returns(uint256)
+func (_CurvePool *CurvePoolTransactorSession) ExchangeUnderlying0(i *big.Int, j *big.Int, dx *big.Int, min_dy *big.Int) (*types.Transaction, error) {
+	return _CurvePool.Contract.ExchangeUnderlying0(&_CurvePool.TransactOpts, i, j, dx, min_dy)
+}
@@ -0,0 +1,594 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package maker
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// OSMABI is the input ABI used to generate the binding from.
+const OSMABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"src_\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"val\",\"type\":\"bytes32\"}],\"name\":\"LogValue\",\"type\":\"event\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"bud\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"hop\",\"outputs\":[{\"internalType\":\"uint16\",\"name\":\"\",\"type\":\"uint16\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"pass\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"ok\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"peek\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"},{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"peep\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"},{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"poke\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"read\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"src\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"stopped\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"wards\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"zzz\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"}]"
+
+// OSM is an auto generated Go binding around an Ethereum contract.
+type OSM struct {
+	OSMCaller     // Read-only binding to the contract
+	OSMTransactor // Write-only binding to the contract
+	OSMFilterer   // Log filterer for contract events
+}
+
+// OSMCaller is an auto generated read-only Go binding around an Ethereum contract.
+type OSMCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OSMTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type OSMTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OSMFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type OSMFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OSMSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type OSMSession struct {
+	Contract     *OSM              // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// OSMCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type OSMCallerSession struct {
+	Contract *OSMCaller    // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// OSMTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type OSMTransactorSession struct {
+	Contract     *OSMTransactor    // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// OSMRaw is an auto generated low-level Go binding around an Ethereum contract.
+type OSMRaw struct {
+	Contract *OSM // Generic contract binding to access the raw methods on
+}
+
+// OSMCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type OSMCallerRaw struct {
+	Contract *OSMCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// OSMTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type OSMTransactorRaw struct {
+	Contract *OSMTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewOSM creates a new instance of OSM, bound to a specific deployed contract.
+func NewOSM(address common.Address, backend bind.ContractBackend) (*OSM, error) {
+	contract, err := bindOSM(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &OSM{OSMCaller: OSMCaller{contract: contract}, OSMTransactor: OSMTransactor{contract: contract}, OSMFilterer: OSMFilterer{contract: contract}}, nil
+}
+
+// NewOSMCaller creates a new read-only instance of OSM, bound to a specific deployed contract.
+func NewOSMCaller(address common.Address, caller bind.ContractCaller) (*OSMCaller, error) {
+	contract, err := bindOSM(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &OSMCaller{contract: contract}, nil
+}
+
+// NewOSMTransactor creates a new write-only instance of OSM, bound to a specific deployed contract.
+func NewOSMTransactor(address common.Address, transactor bind.ContractTransactor) (*OSMTransactor, error) {
+	contract, err := bindOSM(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &OSMTransactor{contract: contract}, nil
+}
+
+// NewOSMFilterer creates a new log filterer instance of OSM, bound to a specific deployed contract.
+func NewOSMFilterer(address common.Address, filterer bind.ContractFilterer) (*OSMFilterer, error) {
+	contract, err := bindOSM(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &OSMFilterer{contract: contract}, nil
+}
+
+// bindOSM binds a generic wrapper to an already deployed contract.
+func bindOSM(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(OSMABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_OSM *OSMRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _OSM.Contract.OSMCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_OSM *OSMRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OSM.Contract.OSMTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_OSM *OSMRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _OSM.Contract.OSMTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_OSM *OSMCallerRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _OSM.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_OSM *OSMTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OSM.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_OSM *OSMTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _OSM.Contract.contract.Transact(opts, method, params...)
+}
+
+// Bud is a free data retrieval call binding the contract method 0x4fce7a2a.
+//
+// Solidity: function bud(address ) view returns(uint256)
+func (_OSM *OSMCaller) Bud(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _OSM.contract.Call(opts, out, "bud", arg0)
+	return *ret0, err
+}
+
+// Bud is a free data retrieval call binding the contract method 0x4fce7a2a.
+//
+// Solidity: function bud(address ) view returns(uint256)
+func (_OSM *OSMSession) Bud(arg0 common.Address) (*big.Int, error) {
+	return _OSM.Contract.Bud(&_OSM.CallOpts, arg0)
+}
+
+// Bud is a free data retrieval call binding the contract method 0x4fce7a2a.
+//
+// Solidity: function bud(address ) view returns(uint256)
+func (_OSM *OSMCallerSession) Bud(arg0 common.Address) (*big.Int, error) {
+	return _OSM.Contract.Bud(&_OSM.CallOpts, arg0)
+}
+
+// Hop is a free data retrieval call binding the contract method 0xb0b8579b.
+//
+// Solidity: function hop() view returns(uint16)
+func (_OSM *OSMCaller) Hop(opts *bind.CallOpts) (uint16, error) {
+	var (
+		ret0 = new(uint16)
+	)
+	out := ret0
+	err := _OSM.contract.Call(opts, out, "hop")
+	return *ret0, err
+}
+
+// Hop is a free data retrieval call binding the contract method 0xb0b8579b.
+//
+// Solidity: function hop() view returns(uint16)
+func (_OSM *OSMSession) Hop() (uint16, error) {
+	return _OSM.Contract.Hop(&_OSM.CallOpts)
+}
+
+// Hop is a free data retrieval call binding the contract method 0xb0b8579b.
+//
+// Solidity: function hop() view returns(uint16)
+func (_OSM *OSMCallerSession) Hop() (uint16, error) {
+	return _OSM.Contract.Hop(&_OSM.CallOpts)
+}
+
+// Pass is a free data retrieval call binding the contract method 0xa7a1ed72.
+//
+// Solidity: function pass() view returns(bool ok)
+func (_OSM *OSMCaller) Pass(opts *bind.CallOpts) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _OSM.contract.Call(opts, out, "pass")
+	return *ret0, err
+}
+
+// Pass is a free data retrieval call binding the contract method 0xa7a1ed72.
+//
+// Solidity: function pass() view returns(bool ok)
+func (_OSM *OSMSession) Pass() (bool, error) {
+	return _OSM.Contract.Pass(&_OSM.CallOpts)
+}
+
+// Pass is a free data retrieval call binding the contract method 0xa7a1ed72.
+//
+// Solidity: function pass() view returns(bool ok)
+func (_OSM *OSMCallerSession) Pass() (bool, error) {
+	return _OSM.Contract.Pass(&_OSM.CallOpts)
+}
+
+// Peek is a free data retrieval call binding the contract method 0x59e02dd7.
+//
+// Solidity: function peek() view returns(bytes32, bool)
+func (_OSM *OSMCaller) Peek(opts *bind.CallOpts) ([32]byte, bool, error) {
+	var (
+		ret0 = new([32]byte)
+		ret1 = new(bool)
+	)
+	out := &[]interface{}{
+		ret0,
+		ret1,
+	}
+	err := _OSM.contract.Call(opts, out, "peek")
+	return *ret0, *ret1, err
+}
+
+// Peek is a free data retrieval call binding the contract method 0x59e02dd7.
+//
+// Solidity: function peek() view returns(bytes32, bool)
+func (_OSM *OSMSession) Peek() ([32]byte, bool, error) {
+	return _OSM.Contract.Peek(&_OSM.CallOpts)
+}
+
+// Peek is a free data retrieval call binding the contract method 0x59e02dd7.
+//
+// Solidity: function peek() view returns(bytes32, bool)
+func (_OSM *OSMCallerSession) Peek() ([32]byte, bool, error) {
+	return _OSM.Contract.Peek(&_OSM.CallOpts)
+}
+
+// Peep is a free data retrieval call binding the contract method 0x0e5a6c70.
+//
+// Solidity: function peep() view returns(bytes32, bool)
+func (_OSM *OSMCaller) Peep(opts *bind.CallOpts) ([32]byte, bool, error) {
+	var (
+		ret0 = new([32]byte)
+		ret1 = new(bool)
+	)
+	out := &[]interface{}{
+		ret0,
+		ret1,
+	}
+	err := _OSM.contract.Call(opts, out, "peep")
+	return *ret0, *ret1, err
+}
+
+// Peep is a free data retrieval call binding the contract method 0x0e5a6c70.
+//
+// Solidity: function peep() view returns(bytes32, bool)
+func (_OSM *OSMSession) Peep() ([32]byte, bool, error) {
+	return _OSM.Contract.Peep(&_OSM.CallOpts)
+}
+
+// Peep is a free data retrieval call binding the contract method 0x0e5a6c70.
+//
+// Solidity: function peep() view returns(bytes32, bool)
+func (_OSM *OSMCallerSession) Peep() ([32]byte, bool, error) {
+	return _OSM.Contract.Peep(&_OSM.CallOpts)
+}
+
+// Read is a free data retrieval call binding the contract method 0x57de26a4.
+//
+// Solidity: function read() view returns(bytes32)
+func (_OSM *OSMCaller) Read(opts *bind.CallOpts) ([32]byte, error) {
+	var (
+		ret0 = new([32]byte)
+	)
+	out := ret0
+	err := _OSM.contract.Call(opts, out, "read")
+	return *ret0, err
+}
+
+// Read is a free data retrieval call binding the contract method 0x57de26a4.
+//
+// Solidity: function read() view returns(bytes32)
+func (_OSM *OSMSession) Read() ([32]byte, error) {
+	return _OSM.Contract.Read(&_OSM.CallOpts)
+}
+
+// Read is a free data retrieval call binding the contract method 0x57de26a4.
+//
+// Solidity: function read() view returns(bytes32)
+func (_OSM *OSMCallerSession) Read() ([32]byte, error) {
+	return _OSM.Contract.Read(&_OSM.CallOpts)
+}
+
+// Src is a free data retrieval call binding the contract method 0x2e7dc6af.
+//
+// Solidity: function src() view returns(address)
+func (_OSM *OSMCaller) Src(opts *bind.CallOpts) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _OSM.contract.Call(opts, out, "src")
+	return *ret0, err
+}
+
+// Src is a free data retrieval call binding the contract method 0x2e7dc6af.
+//
+// Solidity: function src() view returns(address)
+func (_OSM *OSMSession) Src() (common.Address, error) {
+	return _OSM.Contract.Src(&_OSM.CallOpts)
+}
+
+// Src is a free data retrieval call binding the contract method 0x2e7dc6af.
+//
+// Solidity: function src() view returns(address)
+func (_OSM *OSMCallerSession) Src() (common.Address, error) {
+	return _OSM.Contract.Src(&_OSM.CallOpts)
+}
+
+// Stopped is a free data retrieval call binding the contract method 0x75f12b21.
+//
+// Solidity: function stopped() view returns(uint256)
+func (_OSM *OSMCaller) Stopped(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _OSM.contract.Call(opts, out, "stopped")
+	return *ret0, err
+}
+
+// Stopped is a free data retrieval call binding the contract method 0x75f12b21.
+//
+// Solidity: function stopped() view returns(uint256)
+func (_OSM *OSMSession) Stopped() (*big.Int, error) {
+	return _OSM.Contract.Stopped(&_OSM.CallOpts)
+}
+
+// Stopped is a free data retrieval call binding the contract method 0x75f12b21.
+//
+// Solidity: function stopped() view returns(uint256)
+func (_OSM *OSMCallerSession) Stopped() (*big.Int, error) {
+	return _OSM.Contract.Stopped(&_OSM.CallOpts)
+}
+
+// Wards is a free data retrieval call binding the contract method 0xbf353dbb.
+//
+// Solidity: function wards(address ) view returns(uint256)
+func (_OSM *OSMCaller) Wards(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _OSM.contract.Call(opts, out, "wards", arg0)
+	return *ret0, err
+}
+
+// Wards is a free data retrieval call binding the contract method 0xbf353dbb.
+//
+// Solidity: function wards(address ) view returns(uint256)
+func (_OSM *OSMSession) Wards(arg0 common.Address) (*big.Int, error) {
+	return _OSM.Contract.Wards(&_OSM.CallOpts, arg0)
+}
+
+// Wards is a free data retrieval call binding the contract method 0xbf353dbb.
+//
+// Solidity: function wards(address ) view returns(uint256)
+func (_OSM *OSMCallerSession) Wards(arg0 common.Address) (*big.Int, error) {
+	return _OSM.Contract.Wards(&_OSM.CallOpts, arg0)
+}
+
+// Zzz is a free data retrieval call binding the contract method 0xa4dff0a2.
+//
+// Solidity: function zzz() view returns(uint64)
+func (_OSM *OSMCaller) Zzz(opts *bind.CallOpts) (uint64, error) {
+	var (
+		ret0 = new(uint64)
+	)
+	out := ret0
+	err := _OSM.contract.Call(opts, out, "zzz")
+	return *ret0, err
+}
+
+// Zzz is a free data retrieval call binding the contract method 0xa4dff0a2.
+//
+// Solidity: function zzz() view returns(uint64)
+func (_OSM *OSMSession) Zzz() (uint64, error) {
+	return _OSM.Contract.Zzz(&_OSM.CallOpts)
+}
+
+// Zzz is a free data retrieval call binding the contract method 0xa4dff0a2.
+//
+// Solidity: function zzz() view returns(uint64)
+func (_OSM *OSMCallerSession) Zzz() (uint64, error) {
+	return _OSM.Contract.Zzz(&_OSM.CallOpts)
+}
+
+// Poke is a paid mutator transaction binding the contract method 0x18178358.
+//
+// Solidity: function poke() returns()
+func (_OSM *OSMTransactor) Poke(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OSM.contract.Transact(opts, "poke")
+}
+
+// Poke is a paid mutator transaction binding the contract method 0x18178358.
+//
+// Solidity: function poke() returns()
+func (_OSM *OSMSession) Poke() (*types.Transaction, error) {
+	return _OSM.Contract.Poke(&_OSM.TransactOpts)
+}
+
+// Poke is a paid mutator transaction binding the contract method 0x18178358.
+//
+// Solidity: function poke() returns()
+func (_OSM *OSMTransactorSession) Poke() (*types.Transaction, error) {
+	return _OSM.Contract.Poke(&_OSM.TransactOpts)
+}
+
+// OSMLogValueIterator is returned from FilterLogValue and is used to iterate over the raw logs and unpacked data for LogValue events raised by the OSM contract.
+type OSMLogValueIterator struct {
+	Event *OSMLogValue // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OSMLogValueIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OSMLogValue)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OSMLogValue)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OSMLogValueIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OSMLogValueIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OSMLogValue represents a LogValue event raised by the OSM contract.
+type OSMLogValue struct {
+	Val [32]byte
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterLogValue is a free log retrieval operation binding the contract event 0x296ba4ca62c6c21c95e828080cb8aec7481b71390585605300a8a76f9e95b527.
+//
+// Solidity: event LogValue(bytes32 val)
+func (_OSM *OSMFilterer) FilterLogValue(opts *bind.FilterOpts) (*OSMLogValueIterator, error) {
+
+	logs, sub, err := _OSM.contract.FilterLogs(opts, "LogValue")
+	if err != nil {
+		return nil, err
+	}
+	return &OSMLogValueIterator{contract: _OSM.contract, event: "LogValue", logs: logs, sub: sub}, nil
+}
+
+// WatchLogValue is a free log subscription operation binding the contract event 0x296ba4ca62c6c21c95e828080cb8aec7481b71390585605300a8a76f9e95b527.
+//
+// Solidity: event LogValue(bytes32 val)
+func (_OSM *OSMFilterer) WatchLogValue(opts *bind.WatchOpts, sink chan<- *OSMLogValue) (event.Subscription, error) {
+
+	logs, sub, err := _OSM.contract.WatchLogs(opts, "LogValue")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OSMLogValue)
+				if err := _OSM.contract.UnpackLog(event, "LogValue", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLogValue is a log parse operation binding the contract event 0x296ba4ca62c6c21c95e828080cb8aec7481b71390585605300a8a76f9e95b527.
+//
+// Solidity: event LogValue(bytes32 val)
+func (_OSM *OSMFilterer) ParseLogValue(log types.Log) (*OSMLogValue, error) {
+	event := new(OSMLogValue)
+	if err := _OSM.contract.UnpackLog(event, "LogValue", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
@@ -0,0 +1,390 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package maker
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// SpotterABI is the input ABI used to generate the binding from.
+const SpotterABI = "[{\"constant\":true,\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"ilks\",\"outputs\":[{\"internalType\":\"contractPipLike\",\"name\":\"pip\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"mat\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"par\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"ilk\",\"type\":\"bytes32\"}],\"name\":\"poke\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"ilk\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"val\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"spot\",\"type\":\"uint256\"}],\"name\":\"Poke\",\"type\":\"event\"}]"
+
+// Spotter is an auto generated Go binding around an Ethereum contract.
+type Spotter struct {
+	SpotterCaller     // Read-only binding to the contract
+	SpotterTransactor // Write-only binding to the contract
+	SpotterFilterer   // Log filterer for contract events
+}
+
+// SpotterCaller is an auto generated read-only Go binding around an Ethereum contract.
+type SpotterCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SpotterTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type SpotterTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SpotterFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type SpotterFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SpotterSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type SpotterSession struct {
+	Contract     *Spotter          // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// SpotterCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type SpotterCallerSession struct {
+	Contract *SpotterCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
+}
+
+// SpotterTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type SpotterTransactorSession struct {
+	Contract     *SpotterTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// SpotterRaw is an auto generated low-level Go binding around an Ethereum contract.
+type SpotterRaw struct {
+	Contract *Spotter // Generic contract binding to access the raw methods on
+}
+
+// SpotterCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type SpotterCallerRaw struct {
+	Contract *SpotterCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// SpotterTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type SpotterTransactorRaw struct {
+	Contract *SpotterTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewSpotter creates a new instance of Spotter, bound to a specific deployed contract.
+func NewSpotter(address common.Address, backend bind.ContractBackend) (*Spotter, error) {
+	contract, err := bindSpotter(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Spotter{SpotterCaller: SpotterCaller{contract: contract}, SpotterTransactor: SpotterTransactor{contract: contract}, SpotterFilterer: SpotterFilterer{contract: contract}}, nil
+}
+
+// NewSpotterCaller creates a new read-only instance of Spotter, bound to a specific deployed contract.
+func NewSpotterCaller(address common.Address, caller bind.ContractCaller) (*SpotterCaller, error) {
+	contract, err := bindSpotter(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SpotterCaller{contract: contract}, nil
+}
+
+// NewSpotterTransactor creates a new write-only instance of Spotter, bound to a specific deployed contract.
+func NewSpotterTransactor(address common.Address, transactor bind.ContractTransactor) (*SpotterTransactor, error) {
+	contract, err := bindSpotter(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SpotterTransactor{contract: contract}, nil
+}
+
+// NewSpotterFilterer creates a new log filterer instance of Spotter, bound to a specific deployed contract.
+func NewSpotterFilterer(address common.Address, filterer bind.ContractFilterer) (*SpotterFilterer, error) {
+	contract, err := bindSpotter(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &SpotterFilterer{contract: contract}, nil
+}
+
+// bindSpotter binds a generic wrapper to an already deployed contract.
+func bindSpotter(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(SpotterABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Spotter *SpotterRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _Spotter.Contract.SpotterCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Spotter *SpotterRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Spotter.Contract.SpotterTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Spotter *SpotterRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Spotter.Contract.SpotterTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Spotter *SpotterCallerRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _Spotter.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Spotter *SpotterTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Spotter.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Spotter *SpotterTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Spotter.Contract.contract.Transact(opts, method, params...)
+}
+
+// Ilks is a free data retrieval call binding the contract method 0xd9638d36.
+//
+// Solidity: function ilks(bytes32 ) view returns(address pip, uint256 mat)
+func (_Spotter *SpotterCaller) Ilks(opts *bind.CallOpts, arg0 [32]byte) (struct {
+	Pip common.Address
+	Mat *big.Int
+}, error) {
+	ret := new(struct {
+		Pip common.Address
+		Mat *big.Int
+	})
+	out := ret
+	err := _Spotter.contract.Call(opts, out, "ilks", arg0)
+	return *ret, err
+}
+
+// Ilks is a free data retrieval call binding the contract method 0xd9638d36.
+//
+// Solidity: function ilks(bytes32 ) view returns(address pip, uint256 mat)
+func (_Spotter *SpotterSession) Ilks(arg0 [32]byte) (struct {
+	Pip common.Address
+	Mat *big.Int
+}, error) {
+	return _Spotter.Contract.Ilks(&_Spotter.CallOpts, arg0)
+}
+
+// Ilks is a free data retrieval call binding the contract method 0xd9638d36.
+//
+// Solidity: function ilks(bytes32 ) view returns(address pip, uint256 mat)
+func (_Spotter *SpotterCallerSession) Ilks(arg0 [32]byte) (struct {
+	Pip common.Address
+	Mat *big.Int
+}, error) {
+	return _Spotter.Contract.Ilks(&_Spotter.CallOpts, arg0)
+}
+
+// Par is a free data retrieval call binding the contract method 0x495d32cb.
+//
+// Solidity: function par() view returns(uint256)
+func (_Spotter *SpotterCaller) Par(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _Spotter.contract.Call(opts, out, "par")
+	return *ret0, err
+}
+
+// Par is a free data retrieval call binding the contract method 0x495d32cb.
+//
+// Solidity: function par() view returns(uint256)
+func (_Spotter *SpotterSession) Par() (*big.Int, error) {
+	return _Spotter.Contract.Par(&_Spotter.CallOpts)
+}
+
+// Par is a free data retrieval call binding the contract method 0x495d32cb.
+//
+// Solidity: function par() view returns(uint256)
+func (_Spotter *SpotterCallerSession) Par() (*big.Int, error) {
+	return _Spotter.Contract.Par(&_Spotter.CallOpts)
+}
+
+// Poke is a paid mutator transaction binding the contract method 0x1504460f.
+//
+// Solidity: function poke(bytes32 ilk) returns()
+func (_Spotter *SpotterTransactor) Poke(opts *bind.TransactOpts, ilk [32]byte) (*types.Transaction, error) {
+	return _Spotter.contract.Transact(opts, "poke", ilk)
+}
+
+// Poke is a paid mutator transaction binding the contract method 0x1504460f.
+//
+// Solidity: function poke(bytes32 ilk) returns()
+func (_Spotter *SpotterSession) Poke(ilk [32]byte) (*types.Transaction, error) {
+	return _Spotter.Contract.Poke(&_Spotter.TransactOpts, ilk)
+}
+
+// Poke is a paid mutator transaction binding the contract method 0x1504460f.
+//
+// Solidity: function poke(bytes32 ilk) returns()
+func (_Spotter *SpotterTransactorSession) Poke(ilk [32]byte) (*types.Transaction, error) {
+	return _Spotter.Contract.Poke(&_Spotter.TransactOpts, ilk)
+}
+
+// SpotterPokeIterator is returned from FilterPoke and is used to iterate over the raw logs and unpacked data for Poke events raised by the Spotter contract.
+type SpotterPokeIterator struct {
+	Event *SpotterPoke // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SpotterPokeIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SpotterPoke)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SpotterPoke)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SpotterPokeIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SpotterPokeIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SpotterPoke represents a Poke event raised by the Spotter contract.
+type SpotterPoke struct {
+	Ilk  [32]byte
+	Val  [32]byte
+	Spot *big.Int
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterPoke is a free log retrieval operation binding the contract event 0xdfd7467e425a8107cfd368d159957692c25085aacbcf5228ce08f10f2146486e.
+//
+// Solidity: event Poke(bytes32 ilk, bytes32 val, uint256 spot)
+func (_Spotter *SpotterFilterer) FilterPoke(opts *bind.FilterOpts) (*SpotterPokeIterator, error) {
+
+	logs, sub, err := _Spotter.contract.FilterLogs(opts, "Poke")
+	if err != nil {
+		return nil, err
+	}
+	return &SpotterPokeIterator{contract: _Spotter.contract, event: "Poke", logs: logs, sub: sub}, nil
+}
+
+// WatchPoke is a free log subscription operation binding the contract event 0xdfd7467e425a8107cfd368d159957692c25085aacbcf5228ce08f10f2146486e.
+//
+// Solidity: event Poke(bytes32 ilk, bytes32 val, uint256 spot)
+func (_Spotter *SpotterFilterer) WatchPoke(opts *bind.WatchOpts, sink chan<- *SpotterPoke) (event.Subscription, error) {
+
+	logs, sub, err := _Spotter.contract.WatchLogs(opts, "Poke")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SpotterPoke)
+				if err := _Spotter.contract.UnpackLog(event, "Poke", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePoke is a log parse operation binding the contract event 0xdfd7467e425a8107cfd368d159957692c25085aacbcf5228ce08f10f2146486e.
+//
+// Solidity: event Poke(bytes32 ilk, bytes32 val, uint256 spot)
+func (_Spotter *SpotterFilterer) ParsePoke(log types.Log) (*SpotterPoke, error) {
+	event := new(SpotterPoke)
+	if err := _Spotter.contract.UnpackLog(event, "Poke", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
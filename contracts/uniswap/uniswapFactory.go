@@ -0,0 +1,519 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package uniswap
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// UniswapFactoryABI is the input ABI used to generate the binding from.
+const UniswapFactoryABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"token0\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"token1\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"pair\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"PairCreated\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"allPairs\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"pair\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"allPairsLength\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"tokenA\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"tokenB\",\"type\":\"address\"}],\"name\":\"createPair\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"pair\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"feeTo\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"feeToSetter\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"tokenA\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"tokenB\",\"type\":\"address\"}],\"name\":\"getPair\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"pair\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"setFeeTo\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"setFeeToSetter\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+
+// UniswapFactory is an auto generated Go binding around an Ethereum contract.
+type UniswapFactory struct {
+	UniswapFactoryCaller     // Read-only binding to the contract
+	UniswapFactoryTransactor // Write-only binding to the contract
+	UniswapFactoryFilterer   // Log filterer for contract events
+}
+
+// UniswapFactoryCaller is an auto generated read-only Go binding around an Ethereum contract.
+type UniswapFactoryCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// UniswapFactoryTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type UniswapFactoryTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// UniswapFactoryFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type UniswapFactoryFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// UniswapFactorySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type UniswapFactorySession struct {
+	Contract     *UniswapFactory   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// UniswapFactoryCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type UniswapFactoryCallerSession struct {
+	Contract *UniswapFactoryCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// UniswapFactoryTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type UniswapFactoryTransactorSession struct {
+	Contract     *UniswapFactoryTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// UniswapFactoryRaw is an auto generated low-level Go binding around an Ethereum contract.
+type UniswapFactoryRaw struct {
+	Contract *UniswapFactory // Generic contract binding to access the raw methods on
+}
+
+// UniswapFactoryCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type UniswapFactoryCallerRaw struct {
+	Contract *UniswapFactoryCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// UniswapFactoryTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type UniswapFactoryTransactorRaw struct {
+	Contract *UniswapFactoryTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewUniswapFactory creates a new instance of UniswapFactory, bound to a specific deployed contract.
+func NewUniswapFactory(address common.Address, backend bind.ContractBackend) (*UniswapFactory, error) {
+	contract, err := bindUniswapFactory(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &UniswapFactory{UniswapFactoryCaller: UniswapFactoryCaller{contract: contract}, UniswapFactoryTransactor: UniswapFactoryTransactor{contract: contract}, UniswapFactoryFilterer: UniswapFactoryFilterer{contract: contract}}, nil
+}
+
+// NewUniswapFactoryCaller creates a new read-only instance of UniswapFactory, bound to a specific deployed contract.
+func NewUniswapFactoryCaller(address common.Address, caller bind.ContractCaller) (*UniswapFactoryCaller, error) {
+	contract, err := bindUniswapFactory(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &UniswapFactoryCaller{contract: contract}, nil
+}
+
+// NewUniswapFactoryTransactor creates a new write-only instance of UniswapFactory, bound to a specific deployed contract.
+func NewUniswapFactoryTransactor(address common.Address, transactor bind.ContractTransactor) (*UniswapFactoryTransactor, error) {
+	contract, err := bindUniswapFactory(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &UniswapFactoryTransactor{contract: contract}, nil
+}
+
+// NewUniswapFactoryFilterer creates a new log filterer instance of UniswapFactory, bound to a specific deployed contract.
+func NewUniswapFactoryFilterer(address common.Address, filterer bind.ContractFilterer) (*UniswapFactoryFilterer, error) {
+	contract, err := bindUniswapFactory(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &UniswapFactoryFilterer{contract: contract}, nil
+}
+
+// bindUniswapFactory binds a generic wrapper to an already deployed contract.
+func bindUniswapFactory(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(UniswapFactoryABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_UniswapFactory *UniswapFactoryRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _UniswapFactory.Contract.UniswapFactoryCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_UniswapFactory *UniswapFactoryRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _UniswapFactory.Contract.UniswapFactoryTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_UniswapFactory *UniswapFactoryRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _UniswapFactory.Contract.UniswapFactoryTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_UniswapFactory *UniswapFactoryCallerRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _UniswapFactory.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_UniswapFactory *UniswapFactoryTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _UniswapFactory.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_UniswapFactory *UniswapFactoryTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _UniswapFactory.Contract.contract.Transact(opts, method, params...)
+}
+
+// AllPairs is a free data retrieval call binding the contract method 0x1e3dd18b.
+//
+// Solidity: function allPairs(uint256 ) view returns(address pair)
+func (_UniswapFactory *UniswapFactoryCaller) AllPairs(opts *bind.CallOpts, arg0 *big.Int) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _UniswapFactory.contract.Call(opts, out, "allPairs", arg0)
+	return *ret0, err
+}
+
+// AllPairs is a free data retrieval call binding the contract method 0x1e3dd18b.
+//
+// Solidity: function allPairs(uint256 ) view returns(address pair)
+func (_UniswapFactory *UniswapFactorySession) AllPairs(arg0 *big.Int) (common.Address, error) {
+	return _UniswapFactory.Contract.AllPairs(&_UniswapFactory.CallOpts, arg0)
+}
+
+// AllPairs is a free data retrieval call binding the contract method 0x1e3dd18b.
+//
+// Solidity: function allPairs(uint256 ) view returns(address pair)
+func (_UniswapFactory *UniswapFactoryCallerSession) AllPairs(arg0 *big.Int) (common.Address, error) {
+	return _UniswapFactory.Contract.AllPairs(&_UniswapFactory.CallOpts, arg0)
+}
+
+// AllPairsLength is a free data retrieval call binding the contract method 0x574f2ba3.
+//
+// Solidity: function allPairsLength() view returns(uint256)
+func (_UniswapFactory *UniswapFactoryCaller) AllPairsLength(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _UniswapFactory.contract.Call(opts, out, "allPairsLength")
+	return *ret0, err
+}
+
+// AllPairsLength is a free data retrieval call binding the contract method 0x574f2ba3.
+//
+// Solidity: function allPairsLength() view returns(uint256)
+func (_UniswapFactory *UniswapFactorySession) AllPairsLength() (*big.Int, error) {
+	return _UniswapFactory.Contract.AllPairsLength(&_UniswapFactory.CallOpts)
+}
+
+// AllPairsLength is a free data retrieval call binding the contract method 0x574f2ba3.
+//
+// Solidity: function allPairsLength() view returns(uint256)
+func (_UniswapFactory *UniswapFactoryCallerSession) AllPairsLength() (*big.Int, error) {
+	return _UniswapFactory.Contract.AllPairsLength(&_UniswapFactory.CallOpts)
+}
+
+// FeeTo is a free data retrieval call binding the contract method 0x017e7e58.
+//
+// Solidity: function feeTo() view returns(address)
+func (_UniswapFactory *UniswapFactoryCaller) FeeTo(opts *bind.CallOpts) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _UniswapFactory.contract.Call(opts, out, "feeTo")
+	return *ret0, err
+}
+
+// FeeTo is a free data retrieval call binding the contract method 0x017e7e58.
+//
+// Solidity: function feeTo() view returns(address)
+func (_UniswapFactory *UniswapFactorySession) FeeTo() (common.Address, error) {
+	return _UniswapFactory.Contract.FeeTo(&_UniswapFactory.CallOpts)
+}
+
+// FeeTo is a free data retrieval call binding the contract method 0x017e7e58.
+//
+// Solidity: function feeTo() view returns(address)
+func (_UniswapFactory *UniswapFactoryCallerSession) FeeTo() (common.Address, error) {
+	return _UniswapFactory.Contract.FeeTo(&_UniswapFactory.CallOpts)
+}
+
+// FeeToSetter is a free data retrieval call binding the contract method 0x094b7415.
+//
+// Solidity: function feeToSetter() view returns(address)
+func (_UniswapFactory *UniswapFactoryCaller) FeeToSetter(opts *bind.CallOpts) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _UniswapFactory.contract.Call(opts, out, "feeToSetter")
+	return *ret0, err
+}
+
+// FeeToSetter is a free data retrieval call binding the contract method 0x094b7415.
+//
+// Solidity: function feeToSetter() view returns(address)
+func (_UniswapFactory *UniswapFactorySession) FeeToSetter() (common.Address, error) {
+	return _UniswapFactory.Contract.FeeToSetter(&_UniswapFactory.CallOpts)
+}
+
+// FeeToSetter is a free data retrieval call binding the contract method 0x094b7415.
+//
+// Solidity: function feeToSetter() view returns(address)
+func (_UniswapFactory *UniswapFactoryCallerSession) FeeToSetter() (common.Address, error) {
+	return _UniswapFactory.Contract.FeeToSetter(&_UniswapFactory.CallOpts)
+}
+
+// GetPair is a free data retrieval call binding the contract method 0xe6a43905.
+//
+// Solidity: function getPair(address tokenA, address tokenB) view returns(address pair)
+func (_UniswapFactory *UniswapFactoryCaller) GetPair(opts *bind.CallOpts, tokenA common.Address, tokenB common.Address) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _UniswapFactory.contract.Call(opts, out, "getPair", tokenA, tokenB)
+	return *ret0, err
+}
+
+// GetPair is a free data retrieval call binding the contract method 0xe6a43905.
+//
+// Solidity: function getPair(address tokenA, address tokenB) view returns(address pair)
+func (_UniswapFactory *UniswapFactorySession) GetPair(tokenA common.Address, tokenB common.Address) (common.Address, error) {
+	return _UniswapFactory.Contract.GetPair(&_UniswapFactory.CallOpts, tokenA, tokenB)
+}
+
+// GetPair is a free data retrieval call binding the contract method 0xe6a43905.
+//
+// Solidity: function getPair(address tokenA, address tokenB) view returns(address pair)
+func (_UniswapFactory *UniswapFactoryCallerSession) GetPair(tokenA common.Address, tokenB common.Address) (common.Address, error) {
+	return _UniswapFactory.Contract.GetPair(&_UniswapFactory.CallOpts, tokenA, tokenB)
+}
+
+// CreatePair is a paid mutator transaction binding the contract method 0xc9c65396.
+//
+// Solidity: function createPair(address tokenA, address tokenB) returns(address pair)
+func (_UniswapFactory *UniswapFactoryTransactor) CreatePair(opts *bind.TransactOpts, tokenA common.Address, tokenB common.Address) (*types.Transaction, error) {
+	return _UniswapFactory.contract.Transact(opts, "createPair", tokenA, tokenB)
+}
+
+// CreatePair is a paid mutator transaction binding the contract method 0xc9c65396.
+//
+// Solidity: function createPair(address tokenA, address tokenB) returns(address pair)
+func (_UniswapFactory *UniswapFactorySession) CreatePair(tokenA common.Address, tokenB common.Address) (*types.Transaction, error) {
+	return _UniswapFactory.Contract.CreatePair(&_UniswapFactory.TransactOpts, tokenA, tokenB)
+}
+
+// CreatePair is a paid mutator transaction binding the contract method 0xc9c65396.
+//
+// Solidity: function createPair(address tokenA, address tokenB) returns(address pair)
+func (_UniswapFactory *UniswapFactoryTransactorSession) CreatePair(tokenA common.Address, tokenB common.Address) (*types.Transaction, error) {
+	return _UniswapFactory.Contract.CreatePair(&_UniswapFactory.TransactOpts, tokenA, tokenB)
+}
+
+// SetFeeTo is a paid mutator transaction binding the contract method 0xf46901ed.
+//
+// Solidity: function setFeeTo(address ) returns()
+func (_UniswapFactory *UniswapFactoryTransactor) SetFeeTo(opts *bind.TransactOpts, arg0 common.Address) (*types.Transaction, error) {
+	return _UniswapFactory.contract.Transact(opts, "setFeeTo", arg0)
+}
+
+// SetFeeTo is a paid mutator transaction binding the contract method 0xf46901ed.
+//
+// Solidity: function setFeeTo(address ) returns()
+func (_UniswapFactory *UniswapFactorySession) SetFeeTo(arg0 common.Address) (*types.Transaction, error) {
+	return _UniswapFactory.Contract.SetFeeTo(&_UniswapFactory.TransactOpts, arg0)
+}
+
+// SetFeeTo is a paid mutator transaction binding the contract method 0xf46901ed.
+//
+// Solidity: function setFeeTo(address ) returns()
+func (_UniswapFactory *UniswapFactoryTransactorSession) SetFeeTo(arg0 common.Address) (*types.Transaction, error) {
+	return _UniswapFactory.Contract.SetFeeTo(&_UniswapFactory.TransactOpts, arg0)
+}
+
+// SetFeeToSetter is a paid mutator transaction binding the contract method 0xa2e74af6.
+//
+// Solidity: function setFeeToSetter(address ) returns()
+func (_UniswapFactory *UniswapFactoryTransactor) SetFeeToSetter(opts *bind.TransactOpts, arg0 common.Address) (*types.Transaction, error) {
+	return _UniswapFactory.contract.Transact(opts, "setFeeToSetter", arg0)
+}
+
+// SetFeeToSetter is a paid mutator transaction binding the contract method 0xa2e74af6.
+//
+// Solidity: function setFeeToSetter(address ) returns()
+func (_UniswapFactory *UniswapFactorySession) SetFeeToSetter(arg0 common.Address) (*types.Transaction, error) {
+	return _UniswapFactory.Contract.SetFeeToSetter(&_UniswapFactory.TransactOpts, arg0)
+}
+
+// SetFeeToSetter is a paid mutator transaction binding the contract method 0xa2e74af6.
+//
+// Solidity: function setFeeToSetter(address ) returns()
+func (_UniswapFactory *UniswapFactoryTransactorSession) SetFeeToSetter(arg0 common.Address) (*types.Transaction, error) {
+	return _UniswapFactory.Contract.SetFeeToSetter(&_UniswapFactory.TransactOpts, arg0)
+}
+
+// UniswapFactoryPairCreatedIterator is returned from FilterPairCreated and is used to iterate over the raw logs and unpacked data for PairCreated events raised by the UniswapFactory contract.
+type UniswapFactoryPairCreatedIterator struct {
+	Event *UniswapFactoryPairCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *UniswapFactoryPairCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(UniswapFactoryPairCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(UniswapFactoryPairCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *UniswapFactoryPairCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *UniswapFactoryPairCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// UniswapFactoryPairCreated represents a PairCreated event raised by the UniswapFactory contract.
+type UniswapFactoryPairCreated struct {
+	Token0 common.Address
+	Token1 common.Address
+	Pair   common.Address
+	Arg3   *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterPairCreated is a free log retrieval operation binding the contract event 0x0d3648bd0f6ba80134a33ba9275ac585d9d315f0ad8355cddefde31afa28d0e9.
+//
+// Solidity: event PairCreated(address indexed token0, address indexed token1, address pair, uint256 arg3)
+func (_UniswapFactory *UniswapFactoryFilterer) FilterPairCreated(opts *bind.FilterOpts, token0 []common.Address, token1 []common.Address) (*UniswapFactoryPairCreatedIterator, error) {
+
+	var token0Rule []interface{}
+	for _, token0Item := range token0 {
+		token0Rule = append(token0Rule, token0Item)
+	}
+	var token1Rule []interface{}
+	for _, token1Item := range token1 {
+		token1Rule = append(token1Rule, token1Item)
+	}
+
+	logs, sub, err := _UniswapFactory.contract.FilterLogs(opts, "PairCreated", token0Rule, token1Rule)
+	if err != nil {
+		return nil, err
+	}
+	return &UniswapFactoryPairCreatedIterator{contract: _UniswapFactory.contract, event: "PairCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchPairCreated is a free log subscription operation binding the contract event 0x0d3648bd0f6ba80134a33ba9275ac585d9d315f0ad8355cddefde31afa28d0e9.
+//
+// Solidity: event PairCreated(address indexed token0, address indexed token1, address pair, uint256 arg3)
+func (_UniswapFactory *UniswapFactoryFilterer) WatchPairCreated(opts *bind.WatchOpts, sink chan<- *UniswapFactoryPairCreated, token0 []common.Address, token1 []common.Address) (event.Subscription, error) {
+
+	var token0Rule []interface{}
+	for _, token0Item := range token0 {
+		token0Rule = append(token0Rule, token0Item)
+	}
+	var token1Rule []interface{}
+	for _, token1Item := range token1 {
+		token1Rule = append(token1Rule, token1Item)
+	}
+
+	logs, sub, err := _UniswapFactory.contract.WatchLogs(opts, "PairCreated", token0Rule, token1Rule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(UniswapFactoryPairCreated)
+				if err := _UniswapFactory.contract.UnpackLog(event, "PairCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePairCreated is a log parse operation binding the contract event 0x0d3648bd0f6ba80134a33ba9275ac585d9d315f0ad8355cddefde31afa28d0e9.
+//
+// Solidity: event PairCreated(address indexed token0, address indexed token1, address pair, uint256 arg3)
+func (_UniswapFactory *UniswapFactoryFilterer) ParsePairCreated(log types.Log) (*UniswapFactoryPairCreated, error) {
+	event := new(UniswapFactoryPairCreated)
+	if err := _UniswapFactory.contract.UnpackLog(event, "PairCreated", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
@@ -0,0 +1,220 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package zeroEx
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// ITransformERC20FeatureTransformation is an auto generated low-level Go binding around an user-defined struct.
+type ITransformERC20FeatureTransformation struct {
+	DeploymentNonce uint32
+	Data            []byte
+}
+
+// ExchangeProxyABI is the input ABI used to generate the binding from.
+const ExchangeProxyABI = "[{\"inputs\":[{\"internalType\":\"contractIERC20TokenV06\",\"name\":\"inputToken\",\"type\":\"address\"},{\"internalType\":\"contractIERC20TokenV06\",\"name\":\"outputToken\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"inputTokenAmount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"minOutputTokenAmount\",\"type\":\"uint256\"},{\"internalType\":\"structITransformERC20Feature.Transformation[]\",\"name\":\"transformations\",\"type\":\"tuple[]\",\"components\":[{\"internalType\":\"uint32\",\"name\":\"deploymentNonce\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}]}],\"name\":\"transformERC20\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"outputTokenAmount\",\"type\":\"uint256\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIERC20TokenV06[]\",\"name\":\"tokens\",\"type\":\"address[]\"},{\"internalType\":\"uint256\",\"name\":\"sellAmount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"minBuyAmount\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"isSushi\",\"type\":\"bool\"}],\"name\":\"sellToUniswap\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"buyAmount\",\"type\":\"uint256\"}],\"stateMutability\":\"payable\",\"type\":\"function\"}]"
+
+// ExchangeProxy is an auto generated Go binding around an Ethereum contract.
+type ExchangeProxy struct {
+	ExchangeProxyCaller     // Read-only binding to the contract
+	ExchangeProxyTransactor // Write-only binding to the contract
+	ExchangeProxyFilterer   // Log filterer for contract events
+}
+
+// ExchangeProxyCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ExchangeProxyCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ExchangeProxyTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ExchangeProxyTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ExchangeProxyFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ExchangeProxyFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ExchangeProxySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ExchangeProxySession struct {
+	Contract     *ExchangeProxy    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ExchangeProxyCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ExchangeProxyCallerSession struct {
+	Contract *ExchangeProxyCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// ExchangeProxyTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ExchangeProxyTransactorSession struct {
+	Contract     *ExchangeProxyTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// ExchangeProxyRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ExchangeProxyRaw struct {
+	Contract *ExchangeProxy // Generic contract binding to access the raw methods on
+}
+
+// ExchangeProxyCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ExchangeProxyCallerRaw struct {
+	Contract *ExchangeProxyCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ExchangeProxyTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ExchangeProxyTransactorRaw struct {
+	Contract *ExchangeProxyTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewExchangeProxy creates a new instance of ExchangeProxy, bound to a specific deployed contract.
+func NewExchangeProxy(address common.Address, backend bind.ContractBackend) (*ExchangeProxy, error) {
+	contract, err := bindExchangeProxy(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ExchangeProxy{ExchangeProxyCaller: ExchangeProxyCaller{contract: contract}, ExchangeProxyTransactor: ExchangeProxyTransactor{contract: contract}, ExchangeProxyFilterer: ExchangeProxyFilterer{contract: contract}}, nil
+}
+
+// NewExchangeProxyCaller creates a new read-only instance of ExchangeProxy, bound to a specific deployed contract.
+func NewExchangeProxyCaller(address common.Address, caller bind.ContractCaller) (*ExchangeProxyCaller, error) {
+	contract, err := bindExchangeProxy(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ExchangeProxyCaller{contract: contract}, nil
+}
+
+// NewExchangeProxyTransactor creates a new write-only instance of ExchangeProxy, bound to a specific deployed contract.
+func NewExchangeProxyTransactor(address common.Address, transactor bind.ContractTransactor) (*ExchangeProxyTransactor, error) {
+	contract, err := bindExchangeProxy(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ExchangeProxyTransactor{contract: contract}, nil
+}
+
+// NewExchangeProxyFilterer creates a new log filterer instance of ExchangeProxy, bound to a specific deployed contract.
+func NewExchangeProxyFilterer(address common.Address, filterer bind.ContractFilterer) (*ExchangeProxyFilterer, error) {
+	contract, err := bindExchangeProxy(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ExchangeProxyFilterer{contract: contract}, nil
+}
+
+// bindExchangeProxy binds a generic wrapper to an already deployed contract.
+func bindExchangeProxy(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(ExchangeProxyABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ExchangeProxy *ExchangeProxyRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _ExchangeProxy.Contract.ExchangeProxyCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ExchangeProxy *ExchangeProxyRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ExchangeProxy.Contract.ExchangeProxyTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ExchangeProxy *ExchangeProxyRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ExchangeProxy.Contract.ExchangeProxyTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ExchangeProxy *ExchangeProxyCallerRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _ExchangeProxy.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ExchangeProxy *ExchangeProxyTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ExchangeProxy.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ExchangeProxy *ExchangeProxyTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ExchangeProxy.Contract.contract.Transact(opts, method, params...)
+}
+
+// SellToUniswap is a paid mutator transaction binding the contract method 0xd9627aa4.
+//
+// Solidity: function sellToUniswap(address[] tokens, uint256 sellAmount, uint256 minBuyAmount, bool isSushi) payable returns(uint256 buyAmount)
+func (_ExchangeProxy *ExchangeProxyTransactor) SellToUniswap(opts *bind.TransactOpts, tokens []common.Address, sellAmount *big.Int, minBuyAmount *big.Int, isSushi bool) (*types.Transaction, error) {
+	return _ExchangeProxy.contract.Transact(opts, "sellToUniswap", tokens, sellAmount, minBuyAmount, isSushi)
+}
+
+// SellToUniswap is a paid mutator transaction binding the contract method 0xd9627aa4.
+//
+// Solidity: function sellToUniswap(address[] tokens, uint256 sellAmount, uint256 minBuyAmount, bool isSushi) payable returns(uint256 buyAmount)
+func (_ExchangeProxy *ExchangeProxySession) SellToUniswap(tokens []common.Address, sellAmount *big.Int, minBuyAmount *big.Int, isSushi bool) (*types.Transaction, error) {
+	return _ExchangeProxy.Contract.SellToUniswap(&_ExchangeProxy.TransactOpts, tokens, sellAmount, minBuyAmount, isSushi)
+}
+
+// SellToUniswap is a paid mutator transaction binding the contract method 0xd9627aa4.
+//
+// Solidity: function sellToUniswap(address[] tokens, uint256 sellAmount, uint256 minBuyAmount, bool isSushi) payable returns(uint256 buyAmount)
+func (_ExchangeProxy *ExchangeProxyTransactorSession) SellToUniswap(tokens []common.Address, sellAmount *big.Int, minBuyAmount *big.Int, isSushi bool) (*types.Transaction, error) {
+	return _ExchangeProxy.Contract.SellToUniswap(&_ExchangeProxy.TransactOpts, tokens, sellAmount, minBuyAmount, isSushi)
+}
+
+// TransformERC20 is a paid mutator transaction binding the contract method 0x415565b0.
+//
+// Solidity: function transformERC20(address inputToken, address outputToken, uint256 inputTokenAmount, uint256 minOutputTokenAmount, (uint32,bytes)[] transformations) payable returns(uint256 outputTokenAmount)
+func (_ExchangeProxy *ExchangeProxyTransactor) TransformERC20(opts *bind.TransactOpts, inputToken common.Address, outputToken common.Address, inputTokenAmount *big.Int, minOutputTokenAmount *big.Int, transformations []ITransformERC20FeatureTransformation) (*types.Transaction, error) {
+	return _ExchangeProxy.contract.Transact(opts, "transformERC20", inputToken, outputToken, inputTokenAmount, minOutputTokenAmount, transformations)
+}
+
+// TransformERC20 is a paid mutator transaction binding the contract method 0x415565b0.
+//
+// Solidity: function transformERC20(address inputToken, address outputToken, uint256 inputTokenAmount, uint256 minOutputTokenAmount, (uint32,bytes)[] transformations) payable returns(uint256 outputTokenAmount)
+func (_ExchangeProxy *ExchangeProxySession) TransformERC20(inputToken common.Address, outputToken common.Address, inputTokenAmount *big.Int, minOutputTokenAmount *big.Int, transformations []ITransformERC20FeatureTransformation) (*types.Transaction, error) {
+	return _ExchangeProxy.Contract.TransformERC20(&_ExchangeProxy.TransactOpts, inputToken, outputToken, inputTokenAmount, minOutputTokenAmount, transformations)
+}
+
+// TransformERC20 is a paid mutator transaction binding the contract method 0x415565b0.
+//
+// Solidity: function transformERC20(address inputToken, address outputToken, uint256 inputTokenAmount, uint256 minOutputTokenAmount, (uint32,bytes)[] transformations) payable returns(uint256 outputTokenAmount)
+func (_ExchangeProxy *ExchangeProxyTransactorSession) TransformERC20(inputToken common.Address, outputToken common.Address, inputTokenAmount *big.Int, minOutputTokenAmount *big.Int, transformations []ITransformERC20FeatureTransformation) (*types.Transaction, error) {
+	return _ExchangeProxy.Contract.TransformERC20(&_ExchangeProxy.TransactOpts, inputToken, outputToken, inputTokenAmount, minOutputTokenAmount, transformations)
+}
@@ -17,21 +17,70 @@ func main() {
 	var modeType = flag.String("mode", "quick", "Quick mode vs with 'full' inserts to elastic search")
 	// Flush helper flag to delete all
 	var flush = flag.String("flush", "", "Index you want to delete")
+	// Execute a single pending tx against the latest head with the local EVM and print the result
+	var simulate = flag.String("simulate", "", "Hash of the tx you want to simulate")
+	// Execute every classified tx against the latest block to populate localLogs and tags (full mode)
+	var localExec = flag.Bool("localExec", false, "Execute every classified tx against the latest block to populate localLogs and tags")
+	// How many levels of wrapped calls (multicall, Safe execTransaction, proxies) to unwrap into innerCalls
+	var nestedDepth = flag.Int("nestedDepth", 3, "How many levels of wrapped calls (multicall, Safe, proxies) to unwrap, 0 disables it")
+	// Re-verify every chainlink aggregator in the feed registry against mainnet
+	var verifyFeeds = flag.Bool("verifyFeeds", false, "Re-verify the chainlink feed registry")
+	// Decode the logs of a mined tx (hash) or a whole block (number) against the event signature database
+	var decodeLogs = flag.String("logs", "", "Hash of the tx or number of the block you want the logs of")
+	// Mark the bytecode cluster of a contract as a bot, optionally with a label (0xContract:label)
+	var markBot = flag.String("markBot", "", "Address (and optional :label) of the bot contract you want to follow")
+	// List the bytecode clusters in the fingerprint database
+	var clusters = flag.Bool("clusters", false, "Print the bytecode clusters")
+	// Add or replace an address label (0xAddress,Name,category), or remove one
+	var label = flag.String("label", "", "Address, name and category of the label you want to set")
+	var unlabel = flag.String("unlabel", "", "Address you want to remove the label of")
+	// Add an address to the watchlist (0xAddress,Name,category), or remove one
+	var watch = flag.String("watch", "", "Address, name and category you want alerts for")
+	var unwatch = flag.String("unwatch", "", "Address you want to stop watching")
+	// Where watchlist alerts go: stdout, a file path or a webhook URL, comma separated
+	var alerts = flag.String("alerts", "stdout", "Where watchlist alerts go: stdout, a file path or a webhook URL, comma separated")
+	// Parse the rules file and list the rules
+	var checkRules = flag.Bool("checkRules", false, "Validate and print the rules")
 	flag.Parse()
+	services.SetLocalExecution(*localExec)
+	services.SetNestedCallDepth(*nestedDepth)
+	services.SetWatchlistAlertTargets(*alerts)
 	// `go run helios.go -mode=full -flush=transactions` will delete all txs stored in ES
 	if *flush != "" {
 		fmt.Println("Flushing the index:", *flush)
 		// This is irreversable, be careful!
 		tools.FlushIndexData(*flush)
+	} else if *verifyFeeds {
+		services.VerifyChainlinkFeedRegistry(services.GetCurrentClient())
+	} else if *decodeLogs != "" {
+		services.PrintDecodedLogs(*decodeLogs, services.GetCurrentClient())
+	} else if *markBot != "" {
+		services.MarkBotCluster(*markBot, services.GetCurrentClient())
+	} else if *label != "" {
+		services.SetAddressLabel(*label)
+	} else if *unlabel != "" {
+		services.RemoveAddressLabel(*unlabel)
+	} else if *watch != "" {
+		services.AddWatchedAddress(*watch)
+	} else if *unwatch != "" {
+		services.RemoveWatchedAddress(*unwatch)
+	} else if *checkRules {
+		services.CheckRules()
+	} else if *clusters {
+		services.PrintBytecodeClusters()
+	} else if *simulate != "" {
+		services.SimulateTxByHash(*simulate, services.GetCurrentClient())
 	} else {
 		// Initiate client, flags: -client=infura or -client=local
 		rpcClient := services.InitRPCClient()
 		if *modeType == "full" {
 			// Stream news txs, store them depending on mode
+			// Blocks only keep the oracle order book and feed registry in sync for now
+			go services.StreamNewBlocks(rpcClient, true)
 			services.StreamNewTxs(rpcClient, true)
 			//TODO: validate queries before updating a block after being mined
-			//services.StreamNewBlocks(rpcClient)
 		} else {
+			go services.StreamNewBlocks(rpcClient, false)
 			services.StreamNewTxs(rpcClient, false)
 		}
 	}
@@ -0,0 +1,185 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	. "github.com/logrusorgru/aurora"
+	"github.com/taarushv/helios/contracts/aave"
+)
+
+// Aave V2 LendingPool calls (deposit, borrow, repay, liquidationCall, flashLoan)
+// Everything goes through the one pool proxy, assets are passed as underlying token addresses
+var aaveLendingPoolAbi, _ = abi.JSON(strings.NewReader(aave.LendingPoolABI))
+
+const aaveProtocolName = "Aave V2"
+
+var aaveLendingPoolAddress = common.HexToAddress("0x7d2768dE32b0b80b7a3454c06BdAc94A69DDc7A9")
+
+var aaveDeposit = []byte{0xe8, 0xed, 0xa9, 0xdf}         // deposit(address,uint256,address,uint16)
+var aaveBorrow = []byte{0xa4, 0x15, 0xbc, 0xad}          // borrow(address,uint256,uint256,uint16,address)
+var aaveRepay = []byte{0x57, 0x3a, 0xde, 0x81}           // repay(address,uint256,uint256,address)
+var aaveLiquidationCall = []byte{0x00, 0xa7, 0x18, 0xa9} // liquidationCall(address,address,address,uint256,bool)
+var aaveFlashLoan = []byte{0xab, 0x9c, 0x4b, 0x5d}       // flashLoan(address,address[],uint256[],uint256[],address,bytes,uint16)
+
+// DataTypes.InterestRateMode, flash loans use NONE for "pay it back in the same tx"
+var aaveInterestRateModes = map[int64]string{
+	0: "none",
+	1: "stable",
+	2: "variable",
+}
+
+func isAaveLendingPool(tx *types.Transaction) bool {
+	return *tx.To() == aaveLendingPoolAddress
+}
+
+func decodeAaveAction(tx *types.Transaction, client *ethclient.Client) (*lendingAction, error) {
+	selector := tx.Data()[:4]
+	final := &lendingAction{Protocol: aaveProtocolName, Market: aaveLendingPoolAddress.Hex()}
+	var asset common.Address
+	var amount *big.Int
+	switch {
+	case bytes.Equal(selector, aaveDeposit):
+		var params struct {
+			Asset        common.Address
+			Amount       *big.Int
+			OnBehalfOf   common.Address
+			ReferralCode uint16
+		}
+		if err := aaveLendingPoolAbi.Methods["deposit"].Inputs.Unpack(&params, tx.Data()[4:]); err != nil {
+			return nil, err
+		}
+		final.Action, final.Borrower = lendingActionDeposit, params.OnBehalfOf.Hex()
+		asset, amount = params.Asset, params.Amount
+	case bytes.Equal(selector, aaveBorrow):
+		var params struct {
+			Asset            common.Address
+			Amount           *big.Int
+			InterestRateMode *big.Int
+			ReferralCode     uint16
+			OnBehalfOf       common.Address
+		}
+		if err := aaveLendingPoolAbi.Methods["borrow"].Inputs.Unpack(&params, tx.Data()[4:]); err != nil {
+			return nil, err
+		}
+		final.Action, final.Borrower = lendingActionBorrow, params.OnBehalfOf.Hex()
+		final.InterestRateMode = aaveInterestRateModes[params.InterestRateMode.Int64()]
+		asset, amount = params.Asset, params.Amount
+	case bytes.Equal(selector, aaveRepay):
+		var params struct {
+			Asset      common.Address
+			Amount     *big.Int
+			RateMode   *big.Int
+			OnBehalfOf common.Address
+		}
+		if err := aaveLendingPoolAbi.Methods["repay"].Inputs.Unpack(&params, tx.Data()[4:]); err != nil {
+			return nil, err
+		}
+		final.Action, final.Borrower = lendingActionRepay, params.OnBehalfOf.Hex()
+		final.InterestRateMode = aaveInterestRateModes[params.RateMode.Int64()]
+		asset, amount = params.Asset, params.Amount
+	case bytes.Equal(selector, aaveFlashLoan):
+		var params struct {
+			ReceiverAddress common.Address
+			Assets          []common.Address
+			Amounts         []*big.Int
+			Modes           []*big.Int
+			OnBehalfOf      common.Address
+			Params          []byte
+			ReferralCode    uint16
+		}
+		if err := aaveLendingPoolAbi.Methods["flashLoan"].Inputs.Unpack(&params, tx.Data()[4:]); err != nil {
+			return nil, err
+		}
+		if len(params.Assets) != len(params.Amounts) {
+			return nil, fmt.Errorf("flash loan assets/amounts length mismatch")
+		}
+		final.Action, final.Borrower = lendingActionFlashLoan, params.OnBehalfOf.Hex()
+		final.FlashLoanReceiver = params.ReceiverAddress.Hex()
+		// Any non zero mode opens a debt position instead of paying the loan back
+		final.InterestRateMode = aaveInterestRateModes[0]
+		for _, mode := range params.Modes {
+			if mode.Sign() != 0 {
+				final.InterestRateMode = aaveInterestRateModes[mode.Int64()]
+			}
+		}
+		for i, asset := range params.Assets {
+			final.Assets = append(final.Assets, asset.Hex())
+			final.AssetSymbols = append(final.AssetSymbols, getTokenSymbol(asset, client))
+			final.Amounts = append(final.Amounts, formatERC20Decimals(params.Amounts[i], asset, client))
+		}
+		return final, nil
+	default:
+		return nil, nil
+	}
+	final.Assets = []string{asset.Hex()}
+	final.AssetSymbols = []string{getTokenSymbol(asset, client)}
+	final.Amounts = []float64{formatLendingAmount(amount, asset, client)}
+	final.FullAmount = amount.Cmp(maxUint256) == 0
+	return final, nil
+}
+
+func handleAaveLiquidationCall(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+	var params struct {
+		CollateralAsset common.Address
+		DebtAsset       common.Address
+		User            common.Address
+		DebtToCover     *big.Int
+		ReceiveAToken   bool
+	}
+	if err := aaveLendingPoolAbi.Methods["liquidationCall"].Inputs.Unpack(&params, tx.Data()[4:]); err != nil {
+		fmt.Println("Error decoding aave liquidation:", tx.Hash().Hex(), err)
+		if fullMode {
+			handleMiscTx(tx, client, isStealth)
+		}
+		return
+	}
+	final := lendingLiquidation{
+		Protocol:              aaveProtocolName,
+		Liquidator:            getTxSenderAddress(tx, client),
+		Borrower:              params.User.Hex(),
+		DebtAsset:             params.DebtAsset.Hex(),
+		DebtAssetSymbol:       getTokenSymbol(params.DebtAsset, client),
+		RepayAmount:           formatLendingAmount(params.DebtToCover, params.DebtAsset, client),
+		FullAmount:            params.DebtToCover.Cmp(maxUint256) == 0,
+		CollateralAsset:       params.CollateralAsset.Hex(),
+		CollateralAssetSymbol: getTokenSymbol(params.CollateralAsset, client),
+		ReceiveAToken:         params.ReceiveAToken,
+	}
+	fmt.Println()
+	fmt.Println(BrightRed("New TX: Aave Liquidation"))
+	fmt.Println("Hash: ", tx.Hash().Hex(), " Borrower: ", final.Borrower, " Repay: ", final.RepayAmount, final.DebtAssetSymbol, " Seize: ", final.CollateralAssetSymbol)
+	if fullMode {
+		handleLiquidation(tx, client, isStealth, final)
+	}
+}
+
+func handleAaveAction(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+	if bytes.Equal(tx.Data()[:4], aaveLiquidationCall) {
+		handleAaveLiquidationCall(tx, client, isStealth, fullMode)
+		return
+	}
+	final, err := decodeAaveAction(tx, client)
+	if err != nil || final == nil {
+		if err != nil {
+			fmt.Println("Error decoding aave call:", tx.Hash().Hex(), err)
+		}
+		// Withdrawals, collateral toggles, rate swaps etc
+		if fullMode {
+			handleMiscTx(tx, client, isStealth)
+		}
+		return
+	}
+	fmt.Println()
+	fmt.Println(Cyan("New TX: Aave " + strings.Title(final.Action)))
+	fmt.Println("Hash: ", tx.Hash().Hex(), " Amounts: ", final.Amounts, final.AssetSymbols, " Account: ", final.Borrower)
+	if fullMode {
+		handleLendingAction(tx, client, isStealth, *final)
+	}
+}
@@ -0,0 +1,256 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	. "github.com/logrusorgru/aurora"
+)
+
+// Local ABI registry, full ABIs for contracts we don't have (or need) a bespoke classifier for
+// Loaded from ./data/abis (override with ABI_REGISTRY_PATH in .env), one JSON file per contract:
+// {"name": "Compound Comptroller", "addresses": ["0x3d98..."], "abi": [...]}
+// Proxies can point at their implementation instead of carrying an ABI: {"name": "...", "addresses": ["0xproxy"], "implementation": "0ximpl"}
+// The directory is re-checked every few seconds and reloaded when a file changes, so new ABIs don't need a restart
+// Events of every registered ABI are added to the event signature database (eventSignatures.go)
+
+const defaultABIRegistryPath = "./data/abis"
+
+// How often the directory is checked for changes
+const abiRegistryReloadInterval = 10
+
+// Proxy => implementation hops we follow before giving up (guards against loops in the files)
+const abiRegistryMaxProxyHops = 3
+
+type contractABIEntry struct {
+	Name           string          `json:"name"`
+	Addresses      []string        `json:"addresses"`
+	Implementation string          `json:"implementation,omitempty"`
+	ABI            json.RawMessage `json:"abi,omitempty"`
+	parsed         *abi.ABI
+}
+
+var abiRegistry = struct {
+	lock      sync.RWMutex
+	contracts map[common.Address]*contractABIEntry
+	// Proxy => implementation, registered at runtime (survives reloads)
+	implementations map[common.Address]common.Address
+	// Fingerprint of the directory (file names + mod times) the registry was built from
+	fingerprint string
+	checkedAt   int64
+}{
+	contracts:       make(map[common.Address]*contractABIEntry),
+	implementations: make(map[common.Address]common.Address),
+}
+
+// A call decoded against a registered ABI
+type contractCall struct {
+	Contract        string            `json:"contract"`                 // Name from the registry
+	Implementation  string            `json:"implementation,omitempty"` // Set when the ABI came from a proxy's implementation
+	Method          string            `json:"method"`
+	MethodSignature string            `json:"methodSignature"`
+	ArgNames        []string          `json:"argNames"` // Keeps the argument order, maps don't
+	Args            map[string]string `json:"args"`
+}
+
+func getABIRegistryPath() string {
+	if path := os.Getenv("ABI_REGISTRY_PATH"); path != "" {
+		return path
+	}
+	return defaultABIRegistryPath
+}
+
+// File names + mod times, cheap enough to compute on every check
+func getDirectoryFingerprint(files []os.FileInfo) string {
+	var fingerprint strings.Builder
+	for _, f := range files {
+		fmt.Fprintf(&fingerprint, "%s:%d:%d;", f.Name(), f.Size(), f.ModTime().UnixNano())
+	}
+	return fingerprint.String()
+}
+
+// Parse every file in the directory, a broken file is skipped without taking the others down
+func loadABIRegistryFiles(files []os.FileInfo) map[common.Address]*contractABIEntry {
+	contracts := make(map[common.Address]*contractABIEntry)
+	for _, f := range files {
+		if f.IsDir() || filepath.Ext(f.Name()) != ".json" {
+			continue
+		}
+		buf, err := ioutil.ReadFile(filepath.Join(getABIRegistryPath(), f.Name()))
+		if err != nil {
+			continue
+		}
+		var entry contractABIEntry
+		if err := json.Unmarshal(buf, &entry); err != nil {
+			fmt.Println("Error parsing ABI file", f.Name(), err)
+			continue
+		}
+		if len(entry.ABI) > 0 {
+			parsed, err := abi.JSON(bytes.NewReader(entry.ABI))
+			if err != nil {
+				fmt.Println("Error parsing ABI file", f.Name(), err)
+				continue
+			}
+			entry.parsed = &parsed
+			if err := registerEventsFromABI(string(entry.ABI)); err != nil {
+				fmt.Println("Error registering events from", f.Name(), err)
+			}
+		} else if !common.IsHexAddress(entry.Implementation) {
+			fmt.Println("Skipping ABI file without an abi or implementation:", f.Name())
+			continue
+		}
+		if entry.Name == "" {
+			entry.Name = strings.TrimSuffix(f.Name(), ".json")
+		}
+		for _, address := range entry.Addresses {
+			if !common.IsHexAddress(address) {
+				fmt.Println("Skipping invalid address", address, "in", f.Name())
+				continue
+			}
+			contracts[common.HexToAddress(address)] = &entry
+		}
+	}
+	return contracts
+}
+
+// Reload the registry if the directory changed since the last check, must be called without the lock held
+func refreshABIRegistry(force bool) {
+	now := time.Now().Unix()
+	abiRegistry.lock.RLock()
+	due := force || abiRegistry.checkedAt+abiRegistryReloadInterval <= now
+	abiRegistry.lock.RUnlock()
+	if !due {
+		return
+	}
+	abiRegistry.lock.Lock()
+	defer abiRegistry.lock.Unlock()
+	abiRegistry.checkedAt = now
+	files, err := ioutil.ReadDir(getABIRegistryPath())
+	if err != nil {
+		if abiRegistry.fingerprint == "" {
+			fmt.Println("No ABI registry found, starting empty:", getABIRegistryPath())
+			abiRegistry.fingerprint = "missing"
+		}
+		return
+	}
+	fingerprint := getDirectoryFingerprint(files)
+	if fingerprint == abiRegistry.fingerprint {
+		return
+	}
+	abiRegistry.contracts = loadABIRegistryFiles(files)
+	abiRegistry.fingerprint = fingerprint
+	fmt.Println("Loaded", len(abiRegistry.contracts), "contract ABIs")
+}
+
+// Load the registry before the first tx comes in
+func preloadABIRegistry() {
+	refreshABIRegistry(true)
+}
+
+// Point a proxy at its implementation, calls to the proxy are then decoded with the implementation's ABI
+func registerProxyImplementation(proxy common.Address, implementation common.Address) {
+	abiRegistry.lock.Lock()
+	defer abiRegistry.lock.Unlock()
+	abiRegistry.implementations[proxy] = implementation
+}
+
+// Forget the implementations found by resolveProxy, called whenever the head moves. Static implementations from the registry files stay
+func clearProxyImplementations() {
+	abiRegistry.lock.Lock()
+	defer abiRegistry.lock.Unlock()
+	abiRegistry.implementations = make(map[common.Address]common.Address)
+}
+
+// Find the ABI used for calls to `address`, following proxies. The returned name is the first one in the chain (the proxy's, if it has one)
+func lookupContractABI(address common.Address) (string, common.Address, *abi.ABI, bool) {
+	refreshABIRegistry(false)
+	abiRegistry.lock.RLock()
+	defer abiRegistry.lock.RUnlock()
+	name := ""
+	current := address
+	for hops := 0; hops <= abiRegistryMaxProxyHops; hops++ {
+		entry, ok := abiRegistry.contracts[current]
+		if ok && name == "" {
+			name = entry.Name
+		}
+		if ok && entry.parsed != nil {
+			return name, current, entry.parsed, true
+		}
+		if ok && entry.Implementation != "" {
+			current = common.HexToAddress(entry.Implementation)
+		} else if implementation, ok := abiRegistry.implementations[current]; ok {
+			current = implementation
+		} else {
+			break
+		}
+	}
+	return "", common.Address{}, nil, false
+}
+
+// Decode calldata against a full ABI, argument names come from the ABI (arg0, arg1... when it doesn't name them)
+func decodeCallWithABI(contractAbi *abi.ABI, data []byte) (*contractCall, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("calldata too short")
+	}
+	method, err := contractAbi.MethodById(data[:4])
+	if err != nil {
+		return nil, err
+	}
+	values, err := method.Inputs.UnpackValues(data[4:])
+	if err != nil {
+		return nil, err
+	}
+	call := &contractCall{Method: method.RawName, MethodSignature: method.Sig, Args: make(map[string]string)}
+	for i, input := range method.Inputs {
+		name := input.Name
+		if name == "" {
+			name = fmt.Sprintf("arg%d", i)
+		}
+		call.ArgNames = append(call.ArgNames, name)
+		call.Args[name] = formatDecodedValue(values[i])
+	}
+	return call, nil
+}
+
+// Proxies nobody registered are resolved on-chain (proxyResolver.go), so their implementation's ABI is used when we have it
+func isKnownContract(tx *types.Transaction, client *ethclient.Client) (string, common.Address, *abi.ABI, bool) {
+	// Resolve first (cached per block, and resolved implementations are dropped when the head moves) so an upgraded proxy is decoded with its new ABI
+	resolveProxy(*tx.To(), client)
+	return lookupContractABI(*tx.To())
+}
+
+// Calls to contracts with a registered ABI, unknown selectors (fallbacks, methods missing from the ABI) go to misc
+func handleContractCall(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, name string, abiAddress common.Address, contractAbi *abi.ABI) {
+	final, err := decodeCallWithABI(contractAbi, tx.Data())
+	if err != nil {
+		if fullMode {
+			handleMiscTx(tx, client, isStealth)
+		}
+		return
+	}
+	final.Contract = name
+	if abiAddress != *tx.To() {
+		final.Implementation = abiAddress.Hex()
+	}
+	args := make([]string, len(final.ArgNames))
+	for i, argName := range final.ArgNames {
+		args[i] = argName + "=" + final.Args[argName]
+	}
+	fmt.Println()
+	fmt.Println(White("New TX: " + final.Contract + " " + final.Method))
+	fmt.Println("Hash: ", tx.Hash().Hex(), " Args: ", strings.Join(args, ", "))
+	if fullMode {
+		handleContractCallFinal(tx, client, isStealth, *final)
+	}
+}
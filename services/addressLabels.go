@@ -0,0 +1,339 @@
+package services
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	. "github.com/logrusorgru/aurora"
+)
+
+// Address label store, names for the addresses that keep showing up (exchanges, routers, miners, pools, oracle nodes, our own bots)
+// Loaded from ./data/labels (override with ADDRESS_LABELS_PATH in .env), any mix of CSV (`address,label,category`, # for comments) and JSON ([{"address", "label", "category"}]) files
+// Edits from the CLI (`./helios -label=0x...,Name,category` and `-unlabel=0x...`) land in custom.csv, which is loaded last so it wins over the other files
+// The other files are seed data and never rewritten, unlabelling an address they name writes a tombstone (a row with an empty label) to custom.csv instead
+// Like the ABI registry the directory is re-checked every few seconds, a running instance picks up edits without a restart
+// Addresses nobody labelled still get a name when we know them from somewhere else: chainlink feeds and nodes (chainlinkFeedRegistry.go) and bot clusters (bytecodeFingerprint.go)
+
+const defaultAddressLabelsPath = "./data/labels"
+
+// How often the directory is checked for changes
+const addressLabelsReloadInterval = 10
+
+const addressLabelsCustomFile = "custom.csv"
+
+const (
+	labelCategoryExchange = "exchange"
+	labelCategoryRouter   = "router"
+	labelCategoryMiner    = "miner"
+	labelCategoryPool     = "pool"
+	labelCategoryOracle   = "oracle"
+	labelCategoryOwnBot   = "ownBot"
+	labelCategoryBot      = "bot" // Someone else's, from the bytecode fingerprint database
+)
+
+type addressLabel struct {
+	Address  string `json:"address"`
+	Label    string `json:"label"`
+	Category string `json:"category"`
+}
+
+// Embedded in every document
+type addressLabels struct {
+	FromLabel       string   `json:"fromLabel"`
+	ToLabel         string   `json:"toLabel"`
+	FromCategory    string   `json:"fromCategory"`
+	ToCategory      string   `json:"toCategory"`
+	LabelCategories []string `json:"labelCategories"` // Both of the above, for queries that don't care about the direction
+}
+
+var addressLabelStore = struct {
+	lock   sync.RWMutex
+	labels map[common.Address]*addressLabel
+	// Fingerprint of the directory (file names + mod times) the store was built from
+	fingerprint string
+	checkedAt   int64
+}{
+	labels: make(map[common.Address]*addressLabel),
+}
+
+func getAddressLabelsPath() string {
+	if path := os.Getenv("ADDRESS_LABELS_PATH"); path != "" {
+		return path
+	}
+	return defaultAddressLabelsPath
+}
+
+// `address,label[,category]` rows, the header row is optional
+func parseAddressLabelsCSV(buf []byte) ([]*addressLabel, error) {
+	reader := csv.NewReader(bytes.NewReader(buf))
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	var labels []*addressLabel
+	for _, record := range records {
+		if len(record) < 2 || strings.EqualFold(record[0], "address") {
+			continue
+		}
+		label := &addressLabel{Address: strings.TrimSpace(record[0]), Label: strings.TrimSpace(record[1])}
+		if len(record) > 2 {
+			label.Category = strings.TrimSpace(record[2])
+		}
+		labels = append(labels, label)
+	}
+	return labels, nil
+}
+
+func readAddressLabelsFile(name string) ([]*addressLabel, error) {
+	buf, err := ioutil.ReadFile(filepath.Join(getAddressLabelsPath(), name))
+	if err != nil {
+		return nil, err
+	}
+	if filepath.Ext(name) == ".csv" {
+		return parseAddressLabelsCSV(buf)
+	}
+	var labels []*addressLabel
+	err = json.Unmarshal(buf, &labels)
+	return labels, err
+}
+
+// Parse every file in the directory, a broken file is skipped without taking the others down
+func loadAddressLabelFiles(files []os.FileInfo) map[common.Address]*addressLabel {
+	labels := make(map[common.Address]*addressLabel)
+	load := func(name string) {
+		entries, err := readAddressLabelsFile(name)
+		if err != nil {
+			fmt.Println("Error parsing label file", name, err)
+			return
+		}
+		for _, entry := range entries {
+			// Tombstone, drops whatever label the seed files gave the address
+			if name == addressLabelsCustomFile && common.IsHexAddress(entry.Address) && entry.Label == "" {
+				delete(labels, common.HexToAddress(entry.Address))
+				continue
+			}
+			if !common.IsHexAddress(entry.Address) || entry.Label == "" {
+				fmt.Println("Skipping invalid label", entry.Address, "in", name)
+				continue
+			}
+			labels[common.HexToAddress(entry.Address)] = entry
+		}
+	}
+	custom := false
+	for _, f := range files {
+		if f.IsDir() || (filepath.Ext(f.Name()) != ".csv" && filepath.Ext(f.Name()) != ".json") {
+			continue
+		}
+		if f.Name() == addressLabelsCustomFile {
+			custom = true
+			continue
+		}
+		load(f.Name())
+	}
+	if custom {
+		load(addressLabelsCustomFile)
+	}
+	return labels
+}
+
+// Reload the store if the directory changed since the last check, must be called without the lock held
+func refreshAddressLabels(force bool) {
+	now := time.Now().Unix()
+	addressLabelStore.lock.RLock()
+	due := force || addressLabelStore.checkedAt+addressLabelsReloadInterval <= now
+	addressLabelStore.lock.RUnlock()
+	if !due {
+		return
+	}
+	addressLabelStore.lock.Lock()
+	defer addressLabelStore.lock.Unlock()
+	addressLabelStore.checkedAt = now
+	files, err := ioutil.ReadDir(getAddressLabelsPath())
+	if err != nil {
+		if addressLabelStore.fingerprint == "" {
+			fmt.Println("No address labels found, starting empty:", getAddressLabelsPath())
+			addressLabelStore.fingerprint = "missing"
+		}
+		return
+	}
+	fingerprint := getDirectoryFingerprint(files)
+	if fingerprint == addressLabelStore.fingerprint {
+		return
+	}
+	addressLabelStore.labels = loadAddressLabelFiles(files)
+	addressLabelStore.fingerprint = fingerprint
+	fmt.Println("Loaded", len(addressLabelStore.labels), "address labels")
+}
+
+// Label of `address`, from the store first and then from what the other registries know about it. Never touches the chain
+func lookupAddressLabel(address common.Address) (*addressLabel, bool) {
+	refreshAddressLabels(false)
+	addressLabelStore.lock.RLock()
+	label, ok := addressLabelStore.labels[address]
+	addressLabelStore.lock.RUnlock()
+	if ok {
+		return label, true
+	}
+	if feed, ok := lookupChainlinkFeed(address); ok {
+		return &addressLabel{Address: address.Hex(), Label: "Chainlink " + feed.Pair, Category: labelCategoryOracle}, true
+	}
+	if feeds := countChainlinkOracleFeeds(address); feeds > 0 {
+		return &addressLabel{Address: address.Hex(), Label: fmt.Sprintf("Chainlink node (%d feeds)", feeds), Category: labelCategoryOracle}, true
+	}
+	if name, ok := lookupKnownBot(address); ok {
+		return &addressLabel{Address: address.Hex(), Label: name, Category: labelCategoryBot}, true
+	}
+	return nil, false
+}
+
+// Populates the `fromLabel`, `toLabel` and category fields of every document
+func getAddressLabels(tx *types.Transaction, client *ethclient.Client) addressLabels {
+	labels := addressLabels{LabelCategories: []string{}}
+	if label, ok := lookupAddressLabel(common.HexToAddress(getTxSenderAddress(tx, client))); ok {
+		labels.FromLabel, labels.FromCategory = label.Label, label.Category
+	}
+	if tx.To() != nil {
+		if label, ok := lookupAddressLabel(*tx.To()); ok {
+			labels.ToLabel, labels.ToCategory = label.Label, label.Category
+		}
+	}
+	for _, category := range []string{labels.FromCategory, labels.ToCategory} {
+		if category != "" {
+			labels.LabelCategories, _ = appendUnique(labels.LabelCategories, category, 0)
+		}
+	}
+	return labels
+}
+
+func printAddressLabels(tx *types.Transaction, client *ethclient.Client) {
+	labels := getAddressLabels(tx, client)
+	if labels.FromLabel == "" && labels.ToLabel == "" {
+		return
+	}
+	fmt.Println(Cyan("From: "+labels.FromLabel+" ("+labels.FromCategory+")"), Cyan("To: "+labels.ToLabel+" ("+labels.ToCategory+")"))
+}
+
+// Drop the rows for `address` from a CSV file's contents, comments and the other rows are left as they are
+func removeAddressFromCSV(buf []byte, address common.Address) ([]byte, bool) {
+	lines := strings.Split(string(buf), "\n")
+	kept := make([]string, 0, len(lines))
+	for _, line := range lines {
+		field := strings.TrimSpace(strings.SplitN(line, ",", 2)[0])
+		if !common.IsHexAddress(field) || common.HexToAddress(field) != address {
+			kept = append(kept, line)
+		}
+	}
+	return []byte(strings.Join(kept, "\n")), len(kept) != len(lines)
+}
+
+// Append a row to a CSV file, the file is created with an `address,label,category` header if it doesn't exist
+func appendAddressCSVRow(path string, row []string) error {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		buf = []byte("address,label,category\n")
+	}
+	if len(buf) > 0 && buf[len(buf)-1] != '\n' {
+		buf = append(buf, '\n')
+	}
+	var line bytes.Buffer
+	writer := csv.NewWriter(&line)
+	writer.Write(row)
+	writer.Flush()
+	return ioutil.WriteFile(path, append(buf, line.Bytes()...), 0644)
+}
+
+// Parse an `address,label[,category]` CLI argument, csv quoting works for names with commas
+func parseAddressCSVArg(entry string) (common.Address, string, string) {
+	reader := csv.NewReader(strings.NewReader(entry))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	record, err := reader.Read()
+	if err != nil || len(record) < 2 || !common.IsHexAddress(record[0]) || record[1] == "" {
+		log.Fatalln("Expected address,label[,category], got:", entry)
+	}
+	if len(record) < 3 {
+		record = append(record, "")
+	}
+	return common.HexToAddress(record[0]), record[1], record[2]
+}
+
+// Drop the rows for `address` (labels and tombstones) from custom.csv, the seed files are left alone
+func removeCustomAddressLabel(address common.Address) bool {
+	path := filepath.Join(getAddressLabelsPath(), addressLabelsCustomFile)
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return false
+	}
+	updated, removed := removeAddressFromCSV(buf, address)
+	if !removed {
+		return false
+	}
+	if err := ioutil.WriteFile(path, updated, 0644); err != nil {
+		log.Fatalln("Error saving label file", addressLabelsCustomFile, err)
+	}
+	return true
+}
+
+// Whether one of the seed files (everything but custom.csv) labels `address`
+func isSeedAddressLabel(address common.Address) bool {
+	files, err := ioutil.ReadDir(getAddressLabelsPath())
+	if err != nil {
+		return false
+	}
+	seeds := make([]os.FileInfo, 0, len(files))
+	for _, f := range files {
+		if f.Name() != addressLabelsCustomFile {
+			seeds = append(seeds, f)
+		}
+	}
+	_, ok := loadAddressLabelFiles(seeds)[address]
+	return ok
+}
+
+// `./helios -label=0xAddress,Name,category`, replaces whatever label the address had (custom.csv wins over the seed files)
+func SetAddressLabel(entry string) {
+	address, label, category := parseAddressCSVArg(entry)
+	removeCustomAddressLabel(address)
+	if err := appendAddressCSVRow(filepath.Join(getAddressLabelsPath(), addressLabelsCustomFile), []string{address.Hex(), label, category}); err != nil {
+		log.Fatalln("Error saving label:", err)
+	}
+	fmt.Println("Labelled", address.Hex(), "as", label, category)
+}
+
+// `./helios -unlabel=0xAddress`, drops the custom label and tombstones the seed one if there is one
+func RemoveAddressLabel(entry string) {
+	if !common.IsHexAddress(entry) {
+		log.Fatalln("Invalid address:", entry)
+	}
+	address := common.HexToAddress(entry)
+	removed := removeCustomAddressLabel(address)
+	if isSeedAddressLabel(address) {
+		if err := appendAddressCSVRow(filepath.Join(getAddressLabelsPath(), addressLabelsCustomFile), []string{address.Hex(), "", ""}); err != nil {
+			log.Fatalln("Error saving label:", err)
+		}
+		removed = true
+	}
+	if !removed {
+		fmt.Println("No label found for", address.Hex())
+	} else {
+		fmt.Println("Removed the label of", address.Hex())
+	}
+}
@@ -0,0 +1,323 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	. "github.com/logrusorgru/aurora"
+	"github.com/taarushv/helios/contracts/oneInch"
+	"github.com/taarushv/helios/contracts/uniswap"
+	"github.com/taarushv/helios/contracts/zeroEx"
+)
+
+// DEX aggregators (1inch and the 0x Exchange Proxy), trades are indexed as "aggregatorTrade" using the same schema as uniswap trades
+// The route is only known when it's part of the calldata (1inch unoswap, 0x sellToUniswap), generic swaps go through an executor/transformers we can't see into
+var oneInchRouterAbi, _ = abi.JSON(strings.NewReader(oneInch.AggregationRouterABI))
+var zeroExProxyAbi, _ = abi.JSON(strings.NewReader(zeroEx.ExchangeProxyABI))
+
+const oneInchVenueName = "1inch"
+const zeroExVenueName = "0x"
+
+// Router => aggregator
+var aggregatorRouters = map[common.Address]string{
+	common.HexToAddress("0x111111125434b319222CdBf8C261674aDB56F3ae"): oneInchVenueName, // V2
+	common.HexToAddress("0x11111112542D85B3EF69AE05771c2dCCff4fAa26"): oneInchVenueName, // V3
+	common.HexToAddress("0x1111111254fb6c44bAC0beD2854e76F90643097d"): oneInchVenueName, // V4
+	common.HexToAddress("0x1111111254EEB25477B68fb85Ed929f73A960582"): oneInchVenueName, // V5
+	common.HexToAddress("0xDef1C0ded9bec7F1a1670819833240f027b25EfF"): zeroExVenueName,
+}
+
+// 1inch V2
+var oneInchSwapV2 = []byte{0x90, 0x41, 0x1a, 0x32} // swap(address,(address,address,address,address,uint256,uint256,uint256,uint256,address,bytes),(uint256,uint256,uint256,bytes)[])
+
+// 1inch V3-V4
+var oneInchSwap = []byte{0x7c, 0x02, 0x52, 0x00}    // swap(address,(address,address,address,address,uint256,uint256,uint256,bytes),bytes)
+var oneInchUnoswap = []byte{0x2e, 0x95, 0xb6, 0xc8} // unoswap(address,uint256,uint256,bytes32[])
+
+// 1inch V5
+var oneInchSwapV5 = []byte{0x12, 0xaa, 0x3c, 0xaf}    // swap(address,(address,address,address,address,uint256,uint256,uint256),bytes,bytes)
+var oneInchUnoswapV5 = []byte{0x05, 0x02, 0xb1, 0xc5} // unoswap(address,uint256,uint256,uint256[])
+
+// 0x Exchange Proxy
+var zeroExTransformERC20 = []byte{0x41, 0x55, 0x65, 0xb0} // transformERC20(address,address,uint256,uint256,(uint32,bytes)[])
+var zeroExSellToUniswap = []byte{0xd9, 0x62, 0x7a, 0xa4}  // sellToUniswap(address[],uint256,uint256,bool)
+
+// Both aggregators use this for ETH, 1inch unoswap also takes the zero address
+var ethPlaceholderAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+
+// Unoswap pools are packed as flags | fee numerator | pair address
+var unoswapReverseFlag = new(big.Int).Lsh(big.NewInt(1), 255) // token1 => token0
+var unoswapUnwrapFlag = new(big.Int).Lsh(big.NewInt(1), 254)  // Unwrap WETH after the last hop
+
+// Normalised view of any of the aggregator calls
+type aggregatorSwap struct {
+	Method      string
+	TokenIn     common.Address
+	TokenOut    common.Address // Unknown (zero) until the pools are resolved for unoswap
+	AmountIn    *big.Int
+	MinReturn   *big.Int
+	Recipient   common.Address // Zero means the sender
+	Pools       []*big.Int     // 1inch unoswap
+	UniswapPath []common.Address
+	IsSushi     bool // 0x sellToUniswap
+}
+
+func isAggregatorRouter(tx *types.Transaction) (string, bool) {
+	aggregator, ok := aggregatorRouters[*tx.To()]
+	return aggregator, ok
+}
+
+func isEthPlaceholder(token common.Address) bool {
+	return token == ethPlaceholderAddress || token == (common.Address{})
+}
+
+// Like formatERC20Decimals, but aware of the ETH placeholders
+func formatAggregatorAmount(amount *big.Int, token common.Address, client *ethclient.Client) float64 {
+	if isEthPlaceholder(token) {
+		return formatEthWeiToEther(amount)
+	}
+	return formatERC20Decimals(amount, token, client)
+}
+
+func getAggregatorTokenSymbol(token common.Address, client *ethclient.Client) string {
+	if isEthPlaceholder(token) {
+		return "ETH"
+	}
+	return getTokenSymbol(token, client)
+}
+
+func getAggregatorTokenName(token common.Address, client *ethclient.Client) string {
+	if isEthPlaceholder(token) {
+		return "Ether"
+	}
+	return getTokenName(token, client)
+}
+
+func decodeAggregatorSwap(data []byte) (*aggregatorSwap, error) {
+	selector := data[:4]
+	switch {
+	case bytes.Equal(selector, oneInchSwap):
+		var params struct {
+			Caller common.Address
+			Desc   oneInch.AggregationRouterV4SwapDescription
+			Data   []byte
+		}
+		if err := oneInchRouterAbi.Methods["swap"].Inputs.Unpack(&params, data[4:]); err != nil {
+			return nil, err
+		}
+		return &aggregatorSwap{Method: "swap", TokenIn: params.Desc.SrcToken, TokenOut: params.Desc.DstToken, AmountIn: params.Desc.Amount,
+			MinReturn: params.Desc.MinReturnAmount, Recipient: params.Desc.DstReceiver}, nil
+	case bytes.Equal(selector, oneInchSwapV2):
+		var params struct {
+			Caller common.Address
+			Desc   oneInch.OneInchExchangeSwapDescription
+			Calls  []oneInch.IOneInchCallerCallDescription
+		}
+		if err := oneInchRouterAbi.Methods["swap1"].Inputs.Unpack(&params, data[4:]); err != nil {
+			return nil, err
+		}
+		return &aggregatorSwap{Method: "swap", TokenIn: params.Desc.SrcToken, TokenOut: params.Desc.DstToken, AmountIn: params.Desc.Amount,
+			MinReturn: params.Desc.MinReturnAmount, Recipient: params.Desc.DstReceiver}, nil
+	case bytes.Equal(selector, oneInchSwapV5):
+		var params struct {
+			Executor common.Address
+			Desc     oneInch.GenericRouterSwapDescription
+			Permit   []byte
+			Data     []byte
+		}
+		if err := oneInchRouterAbi.Methods["swap0"].Inputs.Unpack(&params, data[4:]); err != nil {
+			return nil, err
+		}
+		return &aggregatorSwap{Method: "swap", TokenIn: params.Desc.SrcToken, TokenOut: params.Desc.DstToken, AmountIn: params.Desc.Amount,
+			MinReturn: params.Desc.MinReturnAmount, Recipient: params.Desc.DstReceiver}, nil
+	case bytes.Equal(selector, oneInchUnoswap):
+		var params struct {
+			SrcToken  common.Address
+			Amount    *big.Int
+			MinReturn *big.Int
+			Pools     [][32]byte
+		}
+		if err := oneInchRouterAbi.Methods["unoswap"].Inputs.Unpack(&params, data[4:]); err != nil {
+			return nil, err
+		}
+		pools := make([]*big.Int, len(params.Pools))
+		for i, pool := range params.Pools {
+			pools[i] = new(big.Int).SetBytes(pool[:])
+		}
+		return &aggregatorSwap{Method: "unoswap", TokenIn: params.SrcToken, AmountIn: params.Amount, MinReturn: params.MinReturn, Pools: pools}, nil
+	case bytes.Equal(selector, oneInchUnoswapV5):
+		var params struct {
+			SrcToken  common.Address
+			Amount    *big.Int
+			MinReturn *big.Int
+			Pools     []*big.Int
+		}
+		if err := oneInchRouterAbi.Methods["unoswap0"].Inputs.Unpack(&params, data[4:]); err != nil {
+			return nil, err
+		}
+		return &aggregatorSwap{Method: "unoswap", TokenIn: params.SrcToken, AmountIn: params.Amount, MinReturn: params.MinReturn, Pools: params.Pools}, nil
+	case bytes.Equal(selector, zeroExTransformERC20):
+		var params struct {
+			InputToken           common.Address
+			OutputToken          common.Address
+			InputTokenAmount     *big.Int
+			MinOutputTokenAmount *big.Int
+			Transformations      []zeroEx.ITransformERC20FeatureTransformation
+		}
+		if err := zeroExProxyAbi.Methods["transformERC20"].Inputs.Unpack(&params, data[4:]); err != nil {
+			return nil, err
+		}
+		return &aggregatorSwap{Method: "transformERC20", TokenIn: params.InputToken, TokenOut: params.OutputToken, AmountIn: params.InputTokenAmount,
+			MinReturn: params.MinOutputTokenAmount}, nil
+	case bytes.Equal(selector, zeroExSellToUniswap):
+		var params struct {
+			Tokens       []common.Address
+			SellAmount   *big.Int
+			MinBuyAmount *big.Int
+			IsSushi      bool
+		}
+		if err := zeroExProxyAbi.Methods["sellToUniswap"].Inputs.Unpack(&params, data[4:]); err != nil {
+			return nil, err
+		}
+		if len(params.Tokens) < 2 {
+			return nil, fmt.Errorf("invalid path length %d", len(params.Tokens))
+		}
+		return &aggregatorSwap{Method: "sellToUniswap", TokenIn: params.Tokens[0], TokenOut: params.Tokens[len(params.Tokens)-1], AmountIn: params.SellAmount,
+			MinReturn: params.MinBuyAmount, UniswapPath: params.Tokens, IsSushi: params.IsSushi}, nil
+	}
+	return nil, nil
+}
+
+// Walk the unoswap pools to get the token path and the venue behind every pair (via pair.factory, nil if it's not in the registry)
+func resolveUnoswapRoute(swap *aggregatorSwap, client *ethclient.Client) ([]common.Address, []common.Address, []*dexVenue, error) {
+	path := []common.Address{swap.TokenIn}
+	if isEthPlaceholder(swap.TokenIn) {
+		path[0] = wethAddress
+	}
+	pairMask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 160), big.NewInt(1))
+	pairs := make([]common.Address, len(swap.Pools))
+	venues := make([]*dexVenue, len(swap.Pools))
+	for i, pool := range swap.Pools {
+		pair := common.BigToAddress(new(big.Int).And(pool, pairMask))
+		pairInstance, err := uniswap.NewUniswapPair(pair, client)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		var tokenOut common.Address
+		if new(big.Int).And(pool, unoswapReverseFlag).Sign() != 0 {
+			tokenOut, err = pairInstance.Token0(nil)
+		} else {
+			tokenOut, err = pairInstance.Token1(nil)
+		}
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("not a pair %s: %v", pair.Hex(), err)
+		}
+		if factory, err := pairInstance.Factory(nil); err == nil {
+			venues[i], _ = lookupDexVenueByFactory(factory)
+		}
+		pairs[i] = pair
+		path = append(path, tokenOut)
+	}
+	return path, pairs, venues, nil
+}
+
+func handleAggregatorTrade(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, aggregator string) {
+	swap, err := decodeAggregatorSwap(tx.Data())
+	if err != nil || swap == nil {
+		if err != nil {
+			fmt.Println("Error decoding "+aggregator+" swap:", tx.Hash().Hex(), err)
+		}
+		// Limit orders, RFQ fills, admin calls etc
+		if fullMode {
+			handleMiscTx(tx, client, isStealth)
+		}
+		return
+	}
+	// Pairs the trade goes through when the route is in the calldata, tokens are in trade direction (WETH instead of ETH)
+	var uniswapPath []common.Address
+	var uniswapVenues []*dexVenue
+	var pairs []common.Address
+	if len(swap.Pools) > 0 {
+		uniswapPath, pairs, uniswapVenues, err = resolveUnoswapRoute(swap, client)
+		if err != nil {
+			fmt.Println("Error resolving unoswap route:", tx.Hash().Hex(), err)
+		} else {
+			swap.TokenOut = uniswapPath[len(uniswapPath)-1]
+			if swap.TokenOut == wethAddress && new(big.Int).And(swap.Pools[len(swap.Pools)-1], unoswapUnwrapFlag).Sign() != 0 {
+				swap.TokenOut = ethPlaceholderAddress
+			}
+		}
+	} else if len(swap.UniswapPath) > 0 {
+		venueName := "Uniswap V2"
+		if swap.IsSushi {
+			venueName = "SushiSwap"
+		}
+		venue, _ := lookupDexVenueByName(venueName)
+		uniswapPath = make([]common.Address, len(swap.UniswapPath))
+		uniswapVenues = make([]*dexVenue, len(swap.UniswapPath)-1)
+		for i, token := range swap.UniswapPath {
+			uniswapPath[i] = token
+			if isEthPlaceholder(token) {
+				uniswapPath[i] = wethAddress
+			}
+			if i > 0 {
+				uniswapVenues[i-1] = venue
+			}
+		}
+	}
+	final := UniswapTradeFinal{
+		Venue:             aggregator,
+		AmountIn:          formatAggregatorAmount(swap.AmountIn, swap.TokenIn, client),
+		AmountOutMin:      formatAggregatorAmount(swap.MinReturn, swap.TokenOut, client),
+		Path:              []string{swap.TokenIn.Hex(), swap.TokenOut.Hex()},
+		To:                swap.Recipient.Hex(),
+		OutputTokenSymbol: getAggregatorTokenSymbol(swap.TokenOut, client),
+		OutputTokenName:   getAggregatorTokenName(swap.TokenOut, client),
+	}
+	if swap.Recipient == (common.Address{}) {
+		final.To = getTxSenderAddress(tx, client)
+	}
+	if swap.TokenOut == (common.Address{}) && len(swap.Pools) > 0 {
+		// Unoswap route didn't resolve, the zero address isn't ETH here
+		final.OutputTokenSymbol, final.OutputTokenName = "", ""
+	}
+	if uniswapVenues != nil {
+		final.Path = make([]string, len(uniswapPath))
+		for i, token := range uniswapPath {
+			final.Path[i] = token.Hex()
+		}
+		// Keep the ETH placeholders so the path matches the tokens the user sends/receives
+		final.Path[0] = swap.TokenIn.Hex()
+		final.Path[len(final.Path)-1] = swap.TokenOut.Hex()
+		final.Route = make([]string, len(uniswapVenues))
+		singleVenue := true
+		for i, venue := range uniswapVenues {
+			final.Route[i] = "unknown"
+			if venue != nil {
+				final.Route[i] = venue.Name
+			}
+			singleVenue = singleVenue && venue != nil && venue == uniswapVenues[0]
+		}
+		// The reserves math assumes the same venue (and fee) on every hop, mixed routes only get their pairs listed
+		if singleVenue {
+			addUniswapTradeImpact(&final, uniswapVenues[0], uniswapPath, swap.AmountIn, swap.MinReturn, false, client)
+		} else if pairs != nil {
+			final.Pairs = make([]string, len(pairs))
+			for i, pair := range pairs {
+				final.Pairs[i] = pair.Hex()
+			}
+		}
+	}
+	fmt.Println()
+	fmt.Println(Red("New TX: " + aggregator + " Trade (" + swap.Method + ")"))
+	fmt.Println("Hash: ", tx.Hash().Hex(), " Amount: ", final.AmountIn, getAggregatorTokenSymbol(swap.TokenIn, client), " For: ", final.OutputTokenSymbol, " Min Return: ", final.AmountOutMin, " Route: ", final.Route)
+	if fullMode {
+		handleAggregatorFinalTrade(tx, client, isStealth, final)
+	}
+}
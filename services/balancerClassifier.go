@@ -0,0 +1,248 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	. "github.com/logrusorgru/aurora"
+	"github.com/taarushv/helios/contracts/balancer"
+)
+
+// Balancer swaps, indexed as "poolTrade" next to Curve
+// V1 pools (BPool) are called directly, V2 swaps all go through the Vault and name the pool by its id (pool address ++ specialization ++ nonce)
+var bPoolAbi, _ = abi.JSON(strings.NewReader(balancer.BPoolABI))
+var balancerVaultAbi, _ = abi.JSON(strings.NewReader(balancer.VaultABI))
+
+const balancerVenueName = "Balancer"
+const balancerV2VenueName = "Balancer V2"
+
+var balancerVaultAddress = common.HexToAddress("0xBA12222222228d8Ba445958a75a0704d566BF2C8")
+
+var bPoolSwapExactAmountIn = []byte{0x82, 0x01, 0xaa, 0x3f} // swapExactAmountIn(address,uint256,address,uint256,uint256)
+var balancerVaultSwap = []byte{0x52, 0xbb, 0xbe, 0x29}      // swap((bytes32,uint8,address,address,uint256,bytes),(address,bool,address,bool),uint256,uint256)
+var balancerVaultBatchSwap = []byte{0x94, 0x5b, 0xce, 0xc9} // batchSwap(uint8,(bytes32,uint256,uint256,uint256,bytes)[],address[],(address,bool,address,bool),int256[],uint256)
+
+// IVault.SwapKind
+const balancerSwapGivenOut = 1
+
+// Tokens bound to each V1 pool, nil for contracts that aren't pools (finalized pools can't change their tokens, so entries are kept for good)
+var bPoolTokenCache = struct {
+	lock   sync.Mutex
+	tokens map[common.Address][]common.Address
+}{
+	tokens: make(map[common.Address][]common.Address),
+}
+
+type bPoolSwapExactAmountInInput struct {
+	TokenIn       common.Address
+	TokenAmountIn *big.Int
+	TokenOut      common.Address
+	MinAmountOut  *big.Int
+	MaxPrice      *big.Int
+}
+
+func isBalancerPoolSwap(tx *types.Transaction) bool {
+	return bytes.Equal(tx.Data()[:4], bPoolSwapExactAmountIn)
+}
+
+func isBalancerVaultSwap(tx *types.Transaction) bool {
+	return *tx.To() == balancerVaultAddress
+}
+
+func getBPoolTokens(pool common.Address, client *ethclient.Client) []common.Address {
+	bPoolTokenCache.lock.Lock()
+	defer bPoolTokenCache.lock.Unlock()
+	if tokens, ok := bPoolTokenCache.tokens[pool]; ok {
+		return tokens
+	}
+	poolInstance, _ := balancer.NewBPool(pool, client)
+	tokens, err := poolInstance.GetCurrentTokens(nil)
+	if err != nil {
+		tokens = nil
+	}
+	bPoolTokenCache.tokens[pool] = tokens
+	return tokens
+}
+
+func handleBalancerPoolSwap(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+	var input bPoolSwapExactAmountInInput
+	err := bPoolAbi.Methods["swapExactAmountIn"].Inputs.Unpack(&input, tx.Data()[4:])
+	if err == nil {
+		tokens := getBPoolTokens(*tx.To(), client)
+		if !containsAddress(tokens, input.TokenIn) || !containsAddress(tokens, input.TokenOut) {
+			err = fmt.Errorf("tokens not bound to %s", tx.To().Hex())
+		}
+	}
+	if err != nil {
+		fmt.Println("Error decoding balancer swap:", tx.Hash().Hex(), err)
+		if fullMode {
+			handleMiscTx(tx, client, isStealth)
+		}
+		return
+	}
+	final := UniswapTradeFinal{
+		Venue:             balancerVenueName,
+		AmountIn:          formatERC20Decimals(input.TokenAmountIn, input.TokenIn, client),
+		AmountOutMin:      formatERC20Decimals(input.MinAmountOut, input.TokenOut, client),
+		Path:              []string{input.TokenIn.Hex(), input.TokenOut.Hex()},
+		To:                getTxSenderAddress(tx, client),
+		OutputTokenSymbol: getTokenSymbol(input.TokenOut, client),
+		OutputTokenName:   getTokenName(input.TokenOut, client),
+		Pairs:             []string{tx.To().Hex()},
+	}
+	fmt.Println()
+	fmt.Println(Red("New TX: Balancer Trade"))
+	fmt.Println("Hash: ", tx.Hash().Hex(), " Amount: ", final.AmountIn, getTokenSymbol(input.TokenIn, client), " For: ", final.OutputTokenSymbol, " Min Return: ", final.AmountOutMin)
+	if fullMode {
+		handlePoolFinalTrade(tx, client, isStealth, final)
+	}
+}
+
+// Pool address is the first 20 bytes of the id
+func getBalancerPoolAddress(poolId [32]byte) common.Address {
+	return common.BytesToAddress(poolId[:20])
+}
+
+func decodeBalancerVaultSwap(data []byte, client *ethclient.Client) (*UniswapTradeFinal, error) {
+	var params struct {
+		SingleSwap balancer.IVaultSingleSwap
+		Funds      balancer.IVaultFundManagement
+		Limit      *big.Int
+		Deadline   *big.Int
+	}
+	if err := balancerVaultAbi.Methods["swap"].Inputs.Unpack(&params, data); err != nil {
+		return nil, err
+	}
+	swap := params.SingleSwap
+	// limit is the min out for GIVEN_IN swaps and the max in for GIVEN_OUT swaps, stored like V2 exact output trades
+	amountIn, amountOutMin := swap.Amount, params.Limit
+	if swap.Kind == balancerSwapGivenOut {
+		amountIn, amountOutMin = params.Limit, swap.Amount
+	}
+	return &UniswapTradeFinal{
+		Venue:        balancerV2VenueName,
+		AmountIn:     formatAggregatorAmount(amountIn, swap.AssetIn, client),
+		AmountOutMin: formatAggregatorAmount(amountOutMin, swap.AssetOut, client),
+		Path:         []string{swap.AssetIn.Hex(), swap.AssetOut.Hex()},
+		Deadline:     params.Deadline.Int64(),
+		To:           params.Funds.Recipient.Hex(),
+		Pairs:        []string{getBalancerPoolAddress(swap.PoolId).Hex()},
+		PoolIds:      []string{common.Hash(swap.PoolId).Hex()},
+	}, nil
+}
+
+// Batches are flattened into one trade from the asset that only goes in to the asset that only comes out
+func decodeBalancerVaultBatchSwap(data []byte, client *ethclient.Client) (*UniswapTradeFinal, error) {
+	var params struct {
+		Kind     uint8
+		Swaps    []balancer.IVaultBatchSwapStep
+		Assets   []common.Address
+		Funds    balancer.IVaultFundManagement
+		Limits   []*big.Int
+		Deadline *big.Int
+	}
+	if err := balancerVaultAbi.Methods["batchSwap"].Inputs.Unpack(&params, data); err != nil {
+		return nil, err
+	}
+	if len(params.Swaps) == 0 || len(params.Limits) != len(params.Assets) {
+		return nil, fmt.Errorf("invalid batch swap")
+	}
+	sent := make(map[uint64]bool)
+	received := make(map[uint64]bool)
+	var order []uint64
+	for _, step := range params.Swaps {
+		if !step.AssetInIndex.IsUint64() || !step.AssetOutIndex.IsUint64() ||
+			step.AssetInIndex.Uint64() >= uint64(len(params.Assets)) || step.AssetOutIndex.Uint64() >= uint64(len(params.Assets)) {
+			return nil, fmt.Errorf("asset index out of range")
+		}
+		for _, index := range []uint64{step.AssetInIndex.Uint64(), step.AssetOutIndex.Uint64()} {
+			if !sent[index] && !received[index] {
+				order = append(order, index)
+			}
+		}
+		sent[step.AssetInIndex.Uint64()] = true
+		received[step.AssetOutIndex.Uint64()] = true
+	}
+	indexIn, indexOut := params.Swaps[0].AssetInIndex.Uint64(), params.Swaps[len(params.Swaps)-1].AssetOutIndex.Uint64()
+	for _, index := range order {
+		if sent[index] && !received[index] {
+			indexIn = index
+		} else if received[index] && !sent[index] {
+			indexOut = index
+		}
+	}
+	tokenIn, tokenOut := params.Assets[indexIn], params.Assets[indexOut]
+	// Exact side of the trade is the sum of the steps touching it (0 means "use the previous step's output"), the other side comes from limits
+	// Positive limits cap what the vault can take, negative ones are the minimum it has to pay out
+	exactAmount := new(big.Int)
+	for _, step := range params.Swaps {
+		if params.Kind == balancerSwapGivenOut && step.AssetOutIndex.Uint64() == indexOut {
+			exactAmount.Add(exactAmount, step.Amount)
+		} else if params.Kind != balancerSwapGivenOut && step.AssetInIndex.Uint64() == indexIn {
+			exactAmount.Add(exactAmount, step.Amount)
+		}
+	}
+	amountIn, amountOutMin := exactAmount, new(big.Int).Neg(params.Limits[indexOut])
+	if params.Kind == balancerSwapGivenOut {
+		amountIn, amountOutMin = params.Limits[indexIn], exactAmount
+	}
+	if amountOutMin.Sign() < 0 {
+		amountOutMin = new(big.Int)
+	}
+	final := &UniswapTradeFinal{
+		Venue:        balancerV2VenueName,
+		AmountIn:     formatAggregatorAmount(amountIn, tokenIn, client),
+		AmountOutMin: formatAggregatorAmount(amountOutMin, tokenOut, client),
+		Deadline:     params.Deadline.Int64(),
+		To:           params.Funds.Recipient.Hex(),
+	}
+	// Input first, output last, intermediate assets in the order the steps touch them
+	final.Path = []string{tokenIn.Hex()}
+	for _, index := range order {
+		if index != indexIn && index != indexOut {
+			final.Path = append(final.Path, params.Assets[index].Hex())
+		}
+	}
+	final.Path = append(final.Path, tokenOut.Hex())
+	for _, step := range params.Swaps {
+		final.Pairs = append(final.Pairs, getBalancerPoolAddress(step.PoolId).Hex())
+		final.PoolIds = append(final.PoolIds, common.Hash(step.PoolId).Hex())
+	}
+	return final, nil
+}
+
+func handleBalancerVaultSwap(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+	var final *UniswapTradeFinal
+	var err error
+	if bytes.Equal(tx.Data()[:4], balancerVaultSwap) {
+		final, err = decodeBalancerVaultSwap(tx.Data()[4:], client)
+	} else if bytes.Equal(tx.Data()[:4], balancerVaultBatchSwap) {
+		final, err = decodeBalancerVaultBatchSwap(tx.Data()[4:], client)
+	}
+	if err != nil || final == nil {
+		if err != nil {
+			fmt.Println("Error decoding balancer vault swap:", tx.Hash().Hex(), err)
+		}
+		// Joins, exits, flash loans, internal balance management
+		if fullMode {
+			handleMiscTx(tx, client, isStealth)
+		}
+		return
+	}
+	tokenOut := common.HexToAddress(final.Path[len(final.Path)-1])
+	final.OutputTokenSymbol = getAggregatorTokenSymbol(tokenOut, client)
+	final.OutputTokenName = getAggregatorTokenName(tokenOut, client)
+	fmt.Println()
+	fmt.Println(Red("New TX: Balancer V2 Trade"))
+	fmt.Println("Hash: ", tx.Hash().Hex(), " Amount: ", final.AmountIn, getAggregatorTokenSymbol(common.HexToAddress(final.Path[0]), client), " For: ", final.OutputTokenSymbol, " Pools: ", len(final.PoolIds))
+	if fullMode {
+		handlePoolFinalTrade(tx, client, isStealth, *final)
+	}
+}
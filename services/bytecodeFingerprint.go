@@ -0,0 +1,460 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	. "github.com/logrusorgru/aurora"
+)
+
+// Bytecode fingerprints, bots get redeployed all the time (new address, tweaked constants, same code) so addresses alone don't follow them
+// The runtime code is normalised before hashing: the solidity metadata is stripped and every PUSH constant is zeroed,
+// so redeploys with a different owner, router or profit threshold baked in end up with the same fingerprint
+// Contracts sharing a fingerprint form a cluster, clusters are marked as bots by hand (`./helios -markBot=0x...`) and the mark carries over to every redeploy
+// Callers and deployers of bot clusters are remembered as operators, anything an operator deploys later on is treated as part of the same bot
+// Txs to a bot cluster (or from one of its operators) are tagged on their documents, see getBotClusterTags
+// Clusters worth keeping (bots and anything with more than one contract) are persisted to ./data/bytecode-fingerprints.json (override with BYTECODE_FINGERPRINTS_PATH in .env)
+// The rest only lives in memory: single contract clusters are pruned once they go quiet and recipients are remembered in a bounded LRU
+
+const defaultBytecodeFingerprintsPath = "./data/bytecode-fingerprints.json"
+
+// Don't rewrite the database on every new contract, changes are flushed at most this often
+const bytecodeFingerprintsSaveInterval = 60
+
+// Callers kept per cluster, popular contracts would grow the file forever otherwise
+const bytecodeClusterMaxCallers = 100
+
+// Addresses (contracts and EOAs) we remember the fingerprint of, least recently seen ones are fetched again when they come back
+const bytecodeFingerprintsCacheSize = 20000
+
+// Single contract clusters that aren't bots are dropped once they haven't been called for this long
+const bytecodeClusterTTL = 3600
+
+// Bot cluster matches per tx, the classifier prints them and the document tags them
+const botClusterMatchesCacheSize = 256
+
+const (
+	tagBotCluster  = "botCluster"
+	tagBotOperator = "botOperator"
+)
+
+type bytecodeCluster struct {
+	Fingerprint string   `json:"fingerprint"`
+	Label       string   `json:"label"` // Set with -markBot, or inherited from the operator's other clusters
+	Bot         bool     `json:"bot"`
+	CodeSize    int      `json:"codeSize"`
+	Contracts   []string `json:"contracts"`
+	Deployers   []string `json:"deployers"`
+	Callers     []string `json:"callers"`
+	FirstSeen   int64    `json:"firstSeen"`
+	LastSeen    int64    `json:"lastSeen"`
+}
+
+var bytecodeFingerprints = struct {
+	lock     sync.Mutex
+	path     string
+	loaded   bool
+	dirty    bool
+	savedAt  int64
+	prunedAt int64
+	clusters map[string]*bytecodeCluster
+	// Contract => fingerprint, empty for addresses without code
+	contracts *lruCache
+	// Contracts of bot clusters => fingerprint, kept out of the LRU so they're never forgotten
+	bots map[common.Address]string
+	// Callers + deployers of bot clusters => fingerprint of the cluster they were seen with
+	operators map[common.Address]string
+}{
+	clusters:  make(map[string]*bytecodeCluster),
+	contracts: newLRUCache(bytecodeFingerprintsCacheSize),
+	bots:      make(map[common.Address]string),
+	operators: make(map[common.Address]string),
+}
+
+// Bot cluster match of a tx, see matchBotCluster. A snapshot taken under the lock, clusters keep changing after the match
+type botClusterMatch struct {
+	bot        bool // The tx goes to a bot cluster
+	id         string
+	contracts  int
+	operators  int
+	isOperator bool // The sender operates a bot cluster
+}
+
+var botClusterMatches = newLRUCache(botClusterMatchesCacheSize)
+
+func getBytecodeFingerprintsPath() string {
+	if path := os.Getenv("BYTECODE_FINGERPRINTS_PATH"); path != "" {
+		return path
+	}
+	return defaultBytecodeFingerprintsPath
+}
+
+// Short name used in tags and on the console, the label when there is one
+func (cluster *bytecodeCluster) id() string {
+	if cluster.Label != "" {
+		return cluster.Label
+	}
+	return cluster.Fingerprint[:10]
+}
+
+func appendUnique(list []string, value string, max int) ([]string, bool) {
+	for _, existing := range list {
+		if existing == value {
+			return list, false
+		}
+	}
+	if max > 0 && len(list) >= max {
+		return list, false
+	}
+	return append(list, value), true
+}
+
+// Metadata stripped, PUSH data zeroed. Opcodes (and so jump destinations) stay where they were
+func normalizeBytecode(code []byte) []byte {
+	if end := findMetadataEnd(code); end >= 0 && end == len(code) {
+		length := int(code[end-2])<<8 | int(code[end-1])
+		code = code[:end-2-length]
+	}
+	normalized := make([]byte, len(code))
+	for i := 0; i < len(code); i++ {
+		normalized[i] = code[i]
+		if code[i] >= opPUSH1 && code[i] <= opPUSH32 {
+			// Zeroed by make, just skip over the data
+			i += int(code[i]-opPUSH1) + 1
+		}
+	}
+	return normalized
+}
+
+// EIP-1167 clones would all collapse into one cluster once the implementation is masked, they're hashed as is instead
+func fingerprintBytecode(code []byte) string {
+	if findMinimalProxyImplementation(code) != (common.Address{}) {
+		return crypto.Keccak256Hash(code).Hex()
+	}
+	return crypto.Keccak256Hash(normalizeBytecode(code)).Hex()
+}
+
+// Load the persisted clusters once, must be called with the lock held
+func loadBytecodeFingerprints() {
+	if bytecodeFingerprints.loaded {
+		return
+	}
+	bytecodeFingerprints.loaded = true
+	bytecodeFingerprints.path = getBytecodeFingerprintsPath()
+	bytecodeFingerprints.savedAt = time.Now().Unix()
+	bytecodeFingerprints.prunedAt = bytecodeFingerprints.savedAt
+	buf, err := ioutil.ReadFile(bytecodeFingerprints.path)
+	if err != nil {
+		fmt.Println("No bytecode fingerprint database found, starting empty:", bytecodeFingerprints.path)
+		return
+	}
+	var clusters []*bytecodeCluster
+	if err := json.Unmarshal(buf, &clusters); err != nil {
+		fmt.Println("Error parsing bytecode fingerprint database:", err)
+		return
+	}
+	for _, cluster := range clusters {
+		bytecodeFingerprints.clusters[cluster.Fingerprint] = cluster
+		for _, contract := range cluster.Contracts {
+			cacheContractFingerprint(common.HexToAddress(contract), cluster)
+		}
+		if cluster.Bot {
+			for _, operator := range append(append([]string{}, cluster.Deployers...), cluster.Callers...) {
+				bytecodeFingerprints.operators[common.HexToAddress(operator)] = cluster.Fingerprint
+			}
+		}
+	}
+}
+
+// Remember the cluster of a contract, must be called with the lock held
+func cacheContractFingerprint(address common.Address, cluster *bytecodeCluster) {
+	if cluster.Bot {
+		bytecodeFingerprints.bots[address] = cluster.Fingerprint
+	} else {
+		bytecodeFingerprints.contracts.add(address, cluster.Fingerprint)
+	}
+}
+
+// Move the contracts of a cluster that just became a bot out of the LRU, must be called with the lock held
+func pinBotClusterContracts(cluster *bytecodeCluster) {
+	for _, contract := range cluster.Contracts {
+		bytecodeFingerprints.bots[common.HexToAddress(contract)] = cluster.Fingerprint
+	}
+}
+
+// Fingerprint of the code at `address` if we've seen it, empty for EOAs. Must be called with the lock held
+func lookupContractFingerprint(address common.Address) (string, bool) {
+	if fingerprint, ok := bytecodeFingerprints.bots[address]; ok {
+		return fingerprint, true
+	}
+	fingerprint, ok := bytecodeFingerprints.contracts.get(address)
+	if !ok {
+		return "", false
+	}
+	return fingerprint.(string), true
+}
+
+// Write the clusters worth keeping back to disk, must be called with the lock held
+func saveBytecodeFingerprints() {
+	clusters := []*bytecodeCluster{}
+	for _, cluster := range bytecodeFingerprints.clusters {
+		if cluster.Bot || len(cluster.Contracts) > 1 {
+			clusters = append(clusters, cluster)
+		}
+	}
+	// Keep the file diff friendly, bots first then by fingerprint
+	sort.Slice(clusters, func(i, j int) bool {
+		if clusters[i].Bot != clusters[j].Bot {
+			return clusters[i].Bot
+		}
+		return clusters[i].Fingerprint < clusters[j].Fingerprint
+	})
+	buf, err := json.MarshalIndent(clusters, "", "  ")
+	if err != nil {
+		fmt.Println("Error encoding bytecode fingerprint database:", err)
+		return
+	}
+	if err := ioutil.WriteFile(bytecodeFingerprints.path, append(buf, '\n'), 0644); err != nil {
+		fmt.Println("Error saving bytecode fingerprint database:", err)
+	}
+	bytecodeFingerprints.dirty = false
+	bytecodeFingerprints.savedAt = time.Now().Unix()
+}
+
+// Drop the single contract clusters nobody called in a while, must be called with the lock held
+// Their contracts are fingerprinted again if they ever come back
+func pruneBytecodeClusters() {
+	now := time.Now().Unix()
+	if bytecodeFingerprints.prunedAt+bytecodeClusterTTL > now {
+		return
+	}
+	bytecodeFingerprints.prunedAt = now
+	for fingerprint, cluster := range bytecodeFingerprints.clusters {
+		if !cluster.Bot && len(cluster.Contracts) <= 1 && cluster.LastSeen+bytecodeClusterTTL <= now {
+			delete(bytecodeFingerprints.clusters, fingerprint)
+		}
+	}
+}
+
+// Flush pending changes if the last save is old enough, must be called with the lock held
+func flushBytecodeFingerprints() {
+	pruneBytecodeClusters()
+	if bytecodeFingerprints.dirty && bytecodeFingerprints.savedAt+bytecodeFingerprintsSaveInterval <= time.Now().Unix() {
+		saveBytecodeFingerprints()
+	}
+}
+
+// Add `address` to the cluster of its code, must be called with the lock held
+// Deployers that operate a bot cluster pass the mark on to whatever they deploy next
+func addContractToCluster(address common.Address, deployer common.Address, code []byte) *bytecodeCluster {
+	fingerprint := fingerprintBytecode(code)
+	now := time.Now().Unix()
+	cluster, ok := bytecodeFingerprints.clusters[fingerprint]
+	if !ok {
+		cluster = &bytecodeCluster{Fingerprint: fingerprint, CodeSize: len(code), Contracts: []string{}, Deployers: []string{}, Callers: []string{}, FirstSeen: now}
+		bytecodeFingerprints.clusters[fingerprint] = cluster
+	}
+	cluster.LastSeen = now
+	var added bool
+	if cluster.Contracts, added = appendUnique(cluster.Contracts, address.Hex(), 0); added && len(cluster.Contracts) > 1 {
+		bytecodeFingerprints.dirty = true
+	}
+	if deployer == (common.Address{}) {
+		cacheContractFingerprint(address, cluster)
+		return cluster
+	}
+	cluster.Deployers, _ = appendUnique(cluster.Deployers, deployer.Hex(), 0)
+	if operated, ok := bytecodeFingerprints.operators[deployer]; ok && !cluster.Bot && operated != fingerprint {
+		cluster.Bot = true
+		if cluster.Label == "" {
+			cluster.Label = bytecodeFingerprints.clusters[operated].Label
+		}
+		bytecodeFingerprints.dirty = true
+		pinBotClusterContracts(cluster)
+		fmt.Println(Magenta("Bot operator " + deployer.Hex() + " deployed new code: " + address.Hex() + " (cluster " + cluster.id() + ")"))
+	}
+	if cluster.Bot {
+		bytecodeFingerprints.operators[deployer] = fingerprint
+		bytecodeFingerprints.dirty = true
+	}
+	cacheContractFingerprint(address, cluster)
+	return cluster
+}
+
+// Fingerprint freshly deployed code, the deployer is known here (unlike contracts we only see being called)
+func registerDeployedContract(address common.Address, deployer common.Address, code []byte) string {
+	bytecodeFingerprints.lock.Lock()
+	defer bytecodeFingerprints.lock.Unlock()
+	loadBytecodeFingerprints()
+	cluster := addContractToCluster(address, deployer, code)
+	flushBytecodeFingerprints()
+	return cluster.Fingerprint
+}
+
+// Cluster of the code at `address`, nil for EOAs (and if the code can't be fetched)
+// Code is only fetched when the address isn't in the cache (or its cluster was pruned)
+func fingerprintContract(address common.Address, client *ethclient.Client) *bytecodeCluster {
+	bytecodeFingerprints.lock.Lock()
+	loadBytecodeFingerprints()
+	fingerprint, ok := lookupContractFingerprint(address)
+	if ok && fingerprint == "" {
+		bytecodeFingerprints.lock.Unlock()
+		return nil
+	}
+	if cluster, found := bytecodeFingerprints.clusters[fingerprint]; ok && found {
+		cluster.LastSeen = time.Now().Unix()
+		bytecodeFingerprints.lock.Unlock()
+		return cluster
+	}
+	bytecodeFingerprints.lock.Unlock()
+	code, err := client.CodeAt(context.Background(), address, nil)
+	if err != nil {
+		return nil
+	}
+	bytecodeFingerprints.lock.Lock()
+	defer bytecodeFingerprints.lock.Unlock()
+	if len(code) == 0 {
+		bytecodeFingerprints.contracts.add(address, "")
+		return nil
+	}
+	return addContractToCluster(address, common.Address{}, code)
+}
+
+// Remember who calls the cluster, callers of a bot cluster become its operators
+func recordClusterCaller(cluster *bytecodeCluster, caller common.Address) {
+	bytecodeFingerprints.lock.Lock()
+	defer bytecodeFingerprints.lock.Unlock()
+	var added bool
+	if cluster.Callers, added = appendUnique(cluster.Callers, caller.Hex(), bytecodeClusterMaxCallers); added && (cluster.Bot || len(cluster.Contracts) > 1) {
+		bytecodeFingerprints.dirty = true
+	}
+	if cluster.Bot {
+		bytecodeFingerprints.operators[caller] = cluster.Fingerprint
+	}
+	flushBytecodeFingerprints()
+}
+
+// Bot cluster the tx goes to and whether the sender operates one
+// Matched once per tx, the document tags and the console output share the result
+func matchBotCluster(tx *types.Transaction, client *ethclient.Client) botClusterMatch {
+	if tx.To() == nil {
+		return botClusterMatch{}
+	}
+	if cached, ok := botClusterMatches.get(tx.Hash()); ok {
+		return cached.(botClusterMatch)
+	}
+	sender := common.HexToAddress(getTxSenderAddress(tx, client))
+	cluster := fingerprintContract(*tx.To(), client)
+	if cluster != nil {
+		recordClusterCaller(cluster, sender)
+	}
+	var match botClusterMatch
+	bytecodeFingerprints.lock.Lock()
+	if cluster != nil && cluster.Bot {
+		match.bot, match.id = true, cluster.id()
+		match.contracts, match.operators = len(cluster.Contracts), len(cluster.Deployers)+len(cluster.Callers)
+	}
+	_, match.isOperator = bytecodeFingerprints.operators[sender]
+	bytecodeFingerprints.lock.Unlock()
+	botClusterMatches.add(tx.Hash(), match)
+	return match
+}
+
+// `botCluster` + `botCluster:<label>` for txs to a bot cluster, `botOperator` for txs sent by one of their operators
+func getBotClusterTags(tx *types.Transaction, client *ethclient.Client) []string {
+	tags := []string{}
+	match := matchBotCluster(tx, client)
+	if match.bot {
+		tags = append(tags, tagBotCluster, tagBotCluster+":"+match.id)
+	}
+	if match.isOperator {
+		tags = append(tags, tagBotOperator)
+	}
+	return tags
+}
+
+func printBotCluster(tx *types.Transaction, client *ethclient.Client) {
+	match := matchBotCluster(tx, client)
+	if match.bot {
+		fmt.Println(Magenta(fmt.Sprintf("Bot cluster %s (%d contracts, %d operators seen)", match.id, match.contracts, match.operators)))
+	} else if match.isOperator {
+		fmt.Println(Magenta("Sent by a bot operator: " + getTxSenderAddress(tx, client)))
+	}
+}
+
+// Mark the cluster of a contract as a bot (`./helios -markBot=0xContract:label`), saved right away
+func MarkBotCluster(target string, client *ethclient.Client) {
+	address, label := target, ""
+	if i := strings.Index(target, ":"); i >= 0 {
+		address, label = target[:i], target[i+1:]
+	}
+	if !common.IsHexAddress(address) {
+		log.Fatalln("Invalid address:", address)
+	}
+	cluster := fingerprintContract(common.HexToAddress(address), client)
+	if cluster == nil {
+		log.Fatalln("No code at", address)
+	}
+	bytecodeFingerprints.lock.Lock()
+	defer bytecodeFingerprints.lock.Unlock()
+	cluster.Bot = true
+	if label != "" {
+		cluster.Label = label
+	}
+	for _, operator := range append(append([]string{}, cluster.Deployers...), cluster.Callers...) {
+		bytecodeFingerprints.operators[common.HexToAddress(operator)] = cluster.Fingerprint
+	}
+	pinBotClusterContracts(cluster)
+	saveBytecodeFingerprints()
+	fmt.Println("Marked", cluster.id(), "as a bot:", cluster.Fingerprint, "contracts:", strings.Join(cluster.Contracts, ","))
+}
+
+// Print the persisted clusters (`./helios -clusters`), the ones with the most contracts first
+func PrintBytecodeClusters() {
+	bytecodeFingerprints.lock.Lock()
+	defer bytecodeFingerprints.lock.Unlock()
+	loadBytecodeFingerprints()
+	clusters := make([]*bytecodeCluster, 0, len(bytecodeFingerprints.clusters))
+	for _, cluster := range bytecodeFingerprints.clusters {
+		clusters = append(clusters, cluster)
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		return len(clusters[i].Contracts) > len(clusters[j].Contracts)
+	})
+	for _, cluster := range clusters {
+		name := cluster.id()
+		if cluster.Bot {
+			name += " (bot)"
+		}
+		fmt.Println(Cyan(name), cluster.Fingerprint, "size:", cluster.CodeSize, "contracts:", len(cluster.Contracts), "deployers:", len(cluster.Deployers), "callers:", len(cluster.Callers))
+		for _, contract := range cluster.Contracts {
+			fmt.Println("  ", contract)
+		}
+	}
+}
+
+// Name of the bot cluster `address` belongs to (or operates), from what's been fingerprinted so far. Never touches the chain
+func lookupKnownBot(address common.Address) (string, bool) {
+	bytecodeFingerprints.lock.Lock()
+	defer bytecodeFingerprints.lock.Unlock()
+	loadBytecodeFingerprints()
+	if cluster, ok := bytecodeFingerprints.clusters[bytecodeFingerprints.bots[address]]; ok && cluster.Bot {
+		return "Bot " + cluster.id(), true
+	}
+	if fingerprint, ok := bytecodeFingerprints.operators[address]; ok {
+		return "Bot operator (" + bytecodeFingerprints.clusters[fingerprint].id() + ")", true
+	}
+	return "", false
+}
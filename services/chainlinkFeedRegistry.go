@@ -0,0 +1,380 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/taarushv/helios/contracts/chainlinkACA"
+	"github.com/taarushv/helios/contracts/chainlinkOCR"
+)
+
+// Registry of chainlink aggregators (replaces the hand maintained priceFeedACAList)
+// Feeds are discovered from `submit`/`transmit` traffic and NewRound/AnswerUpdated logs, verified on-chain via description() + getOracles() (transmitters() for OCR)
+// and persisted to disk so we don't have to rediscover them on every restart
+// The seed list (data/chainlink-feeds.json) is only ever read, what we learn at runtime goes to a separate state file layered on top of it
+
+// Default location of the seed list, override with CHAINLINK_FEEDS_PATH in .env
+const defaultChainlinkFeedsPath = "./data/chainlink-feeds.json"
+
+// Default location of the discovered/verified feeds, override with CHAINLINK_FEEDS_STATE_PATH in .env
+const defaultChainlinkFeedsStatePath = "./data/chainlink-feeds-discovered.json"
+
+// How long a feed's oracle list is trusted before we fetch it again
+const chainlinkOraclesRefreshInterval = 60 * 60
+
+// Don't hammer getOracles() when an unknown sender shows up repeatedly
+const chainlinkOraclesMinRefreshInterval = 60
+
+// Flux aggregators take one `submit` per oracle, OCR aggregators take a single `transmit` per report
+const (
+	chainlinkFeedTypeFlux = "flux"
+	chainlinkFeedTypeOCR  = "ocr"
+)
+
+type chainlinkFeed struct {
+	Pair            string   `json:"pair"`            // "ETH/USD"
+	ContractAddress string   `json:"contractAddress"` // "0xf00ba7..."
+	Type            string   `json:"type"`            // "flux" or "ocr"
+	Decimals        uint8    `json:"decimals"`
+	Oracles         []string `json:"oracles"`           // Node addresses allowed to submit (transmitters for OCR feeds)
+	VerifiedAt      int64    `json:"verifiedAt"`        // Unix time of the last on-chain verification
+	Removed         bool     `json:"removed,omitempty"` // State file only, a seeded feed that turned out not to be an aggregator (anymore)
+}
+
+var chainlinkFeedRegistry = struct {
+	lock      sync.Mutex
+	statePath string
+	feeds     map[common.Address]*chainlinkFeed
+	// Seeded feeds that failed verification, written to the state file so they stay gone
+	removed map[common.Address]bool
+	// Contracts that answered but aren't aggregators, so we don't retry them on every tx
+	rejected map[common.Address]bool
+	// Verification failed for reasons unrelated to the contract (RPC errors), don't retry before this time
+	retryAt map[common.Address]int64
+	loaded  bool
+}{
+	feeds:    make(map[common.Address]*chainlinkFeed),
+	removed:  make(map[common.Address]bool),
+	rejected: make(map[common.Address]bool),
+	retryAt:  make(map[common.Address]int64),
+}
+
+// Topics used to discover aggregators from logs
+var (
+	chainlinkNewRoundTopic      = accessControlledAggregatorAbi.Events["NewRound"].ID
+	chainlinkAnswerUpdatedTopic = accessControlledAggregatorAbi.Events["AnswerUpdated"].ID
+)
+
+func getChainlinkFeedsPath() string {
+	if path := os.Getenv("CHAINLINK_FEEDS_PATH"); path != "" {
+		return path
+	}
+	return defaultChainlinkFeedsPath
+}
+
+func getChainlinkFeedsStatePath() string {
+	if path := os.Getenv("CHAINLINK_FEEDS_STATE_PATH"); path != "" {
+		return path
+	}
+	return defaultChainlinkFeedsStatePath
+}
+
+func readChainlinkFeedsFile(path string) ([]*chainlinkFeed, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var feeds []*chainlinkFeed
+	err = json.Unmarshal(buf, &feeds)
+	return feeds, err
+}
+
+// Load the seed list and the state file on top of it once, must be called with the registry lock held
+func loadChainlinkFeedRegistry() {
+	if chainlinkFeedRegistry.loaded {
+		return
+	}
+	chainlinkFeedRegistry.loaded = true
+	chainlinkFeedRegistry.statePath = getChainlinkFeedsStatePath()
+	feeds, err := readChainlinkFeedsFile(getChainlinkFeedsPath())
+	if os.IsNotExist(err) {
+		fmt.Println("No chainlink feed registry found, starting empty:", getChainlinkFeedsPath())
+	} else if err != nil {
+		fmt.Println("Error parsing chainlink feed registry:", err)
+	}
+	for _, feed := range feeds {
+		chainlinkFeedRegistry.feeds[common.HexToAddress(feed.ContractAddress)] = feed
+	}
+	state, err := readChainlinkFeedsFile(chainlinkFeedRegistry.statePath)
+	if err != nil && !os.IsNotExist(err) {
+		fmt.Println("Error parsing chainlink feed state:", err)
+	}
+	for _, feed := range state {
+		address := common.HexToAddress(feed.ContractAddress)
+		if feed.Removed {
+			delete(chainlinkFeedRegistry.feeds, address)
+			chainlinkFeedRegistry.removed[address] = true
+			chainlinkFeedRegistry.rejected[address] = true
+			continue
+		}
+		chainlinkFeedRegistry.feeds[address] = feed
+	}
+}
+
+// Write what was verified at runtime to the state file, must be called with the registry lock held
+func saveChainlinkFeedRegistry() {
+	feeds := make([]*chainlinkFeed, 0, len(chainlinkFeedRegistry.feeds))
+	for _, feed := range chainlinkFeedRegistry.feeds {
+		if feed.VerifiedAt > 0 {
+			feeds = append(feeds, feed)
+		}
+	}
+	for address := range chainlinkFeedRegistry.removed {
+		feeds = append(feeds, &chainlinkFeed{ContractAddress: address.Hex(), Oracles: []string{}, Removed: true})
+	}
+	// Keep the file diff friendly, sorted by pair then address
+	sort.Slice(feeds, func(i, j int) bool {
+		if feeds[i].Pair != feeds[j].Pair {
+			return feeds[i].Pair < feeds[j].Pair
+		}
+		return feeds[i].ContractAddress < feeds[j].ContractAddress
+	})
+	buf, err := json.MarshalIndent(feeds, "", "  ")
+	if err != nil {
+		fmt.Println("Error encoding chainlink feed registry:", err)
+		return
+	}
+	if err := ioutil.WriteFile(chainlinkFeedRegistry.statePath, append(buf, '\n'), 0644); err != nil {
+		fmt.Println("Error saving chainlink feed registry:", err)
+	}
+}
+
+// Check the contract on-chain, returns nil (and no error) if it doesn't look like an aggregator
+// An error means we couldn't tell, the feed should be left alone and checked again later
+func verifyChainlinkFeed(address common.Address, client *ethclient.Client) (*chainlinkFeed, error) {
+	notAggregator := func(err error) (*chainlinkFeed, error) {
+		if err == nil || isContractCallRejected(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	ACAInstance, err := chainlinkACA.NewChainlinkACA(address, client)
+	if err != nil {
+		return nil, err
+	}
+	description, err := ACAInstance.Description(nil)
+	if err != nil || description == "" {
+		return notAggregator(err)
+	}
+	// Flux aggregators list their oracles, OCR aggregators their transmitters (getOracles() reverts there)
+	feedType := chainlinkFeedTypeFlux
+	oracles, err := ACAInstance.GetOracles(nil)
+	if err != nil && !isContractCallRejected(err) {
+		return nil, err
+	}
+	if err != nil || len(oracles) == 0 {
+		OCRInstance, err := chainlinkOCR.NewChainlinkOCR(address, client)
+		if err != nil {
+			return nil, err
+		}
+		oracles, err = OCRInstance.Transmitters(nil)
+		if err != nil || len(oracles) == 0 {
+			return notAggregator(err)
+		}
+		feedType = chainlinkFeedTypeOCR
+	}
+	decimals, err := ACAInstance.Decimals(nil)
+	if err != nil {
+		return notAggregator(err)
+	}
+	feed := &chainlinkFeed{
+		Pair:            strings.ReplaceAll(description, " ", ""),
+		ContractAddress: address.Hex(),
+		Type:            feedType,
+		Decimals:        decimals,
+		Oracles:         make([]string, len(oracles)),
+		VerifiedAt:      time.Now().Unix(),
+	}
+	for i, oracle := range oracles {
+		feed.Oracles[i] = oracle.Hex()
+	}
+	return feed, nil
+}
+
+// Return the feed at `address`, verifying (and persisting) it first if we haven't seen it before
+func discoverChainlinkFeed(address common.Address, client *ethclient.Client) *chainlinkFeed {
+	return refreshChainlinkFeed(address, client, false)
+}
+
+// Same as discoverChainlinkFeed, `force` re-verifies a feed even if its oracle list is still fresh
+// The registry is only locked around the bookkeeping, lookups aren't blocked while the aggregator is queried
+func refreshChainlinkFeed(address common.Address, client *ethclient.Client, force bool) *chainlinkFeed {
+	now := time.Now().Unix()
+	chainlinkFeedRegistry.lock.Lock()
+	loadChainlinkFeedRegistry()
+	feed, ok := chainlinkFeedRegistry.feeds[address]
+	fresh := ok && !force && feed.VerifiedAt+chainlinkOraclesRefreshInterval > now
+	skip := (!ok && chainlinkFeedRegistry.rejected[address]) || chainlinkFeedRegistry.retryAt[address] > now
+	chainlinkFeedRegistry.lock.Unlock()
+	if fresh || skip {
+		return feed
+	}
+
+	verified, err := verifyChainlinkFeed(address, client)
+
+	chainlinkFeedRegistry.lock.Lock()
+	defer chainlinkFeedRegistry.lock.Unlock()
+	if err != nil {
+		fmt.Println("Error verifying chainlink feed:", address.Hex(), err)
+		chainlinkFeedRegistry.retryAt[address] = now + chainlinkOraclesMinRefreshInterval
+		return feed
+	}
+	delete(chainlinkFeedRegistry.retryAt, address)
+	if verified == nil {
+		if ok {
+			// Feed stopped answering (deprecated/migrated aggregator), drop it
+			fmt.Println("Chainlink feed failed verification, removing:", feed.Pair, address.Hex())
+			delete(chainlinkFeedRegistry.feeds, address)
+			chainlinkFeedRegistry.removed[address] = true
+			saveChainlinkFeedRegistry()
+		}
+		chainlinkFeedRegistry.rejected[address] = true
+		return nil
+	}
+	if !ok {
+		fmt.Println("New chainlink feed discovered:", verified.Pair, address.Hex())
+	} else if feed.Pair != verified.Pair {
+		fmt.Println("Chainlink feed description changed:", feed.Pair, "=>", verified.Pair, address.Hex())
+	}
+	chainlinkFeedRegistry.feeds[address] = verified
+	delete(chainlinkFeedRegistry.removed, address)
+	delete(chainlinkFeedRegistry.rejected, address)
+	saveChainlinkFeedRegistry()
+	return verified
+}
+
+// Look up a feed without touching the chain
+func lookupChainlinkFeed(address common.Address) (*chainlinkFeed, bool) {
+	chainlinkFeedRegistry.lock.Lock()
+	defer chainlinkFeedRegistry.lock.Unlock()
+	loadChainlinkFeedRegistry()
+	feed, ok := chainlinkFeedRegistry.feeds[address]
+	return feed, ok
+}
+
+func (feed *chainlinkFeed) hasOracle(oracle common.Address) bool {
+	for _, address := range feed.Oracles {
+		if common.HexToAddress(address) == oracle {
+			return true
+		}
+	}
+	return false
+}
+
+// A `submit` tx is only an oracle update if it goes to a verified aggregator and comes from one of its oracles
+// Oracle sets change over time, so an unknown sender triggers a (rate limited) refresh before we give up on it
+func isAuthorisedChainlinkSubmission(tx *types.Transaction, client *ethclient.Client) (*chainlinkFeed, bool) {
+	feed := discoverChainlinkFeed(*tx.To(), client)
+	if feed == nil {
+		return nil, false
+	}
+	sender := common.HexToAddress(getTxSenderAddress(tx, client))
+	if feed.hasOracle(sender) {
+		return feed, true
+	}
+	if feed.VerifiedAt+chainlinkOraclesMinRefreshInterval > time.Now().Unix() {
+		return feed, false
+	}
+	feed = refreshChainlinkFeed(*tx.To(), client, true)
+	if feed == nil {
+		return nil, false
+	}
+	return feed, feed.hasOracle(sender)
+}
+
+// Pick up aggregators from NewRound/AnswerUpdated logs (mined blocks or local execution)
+func discoverChainlinkFeedsFromLogs(logs []*types.Log, client *ethclient.Client) {
+	seen := make(map[common.Address]bool)
+	for _, vLog := range logs {
+		if len(vLog.Topics) == 0 || seen[vLog.Address] {
+			continue
+		}
+		if vLog.Topics[0] == chainlinkNewRoundTopic || vLog.Topics[0] == chainlinkAnswerUpdatedTopic {
+			seen[vLog.Address] = true
+			discoverChainlinkFeed(vLog.Address, client)
+		}
+	}
+}
+
+// Scan a mined block for aggregator logs
+func discoverChainlinkFeedsInBlock(block *types.Block, client *ethclient.Client) {
+	blockHash := block.Hash()
+	logs, err := client.FilterLogs(context.Background(), ethereum.FilterQuery{
+		BlockHash: &blockHash,
+		Topics:    [][]common.Hash{{chainlinkNewRoundTopic, chainlinkAnswerUpdatedTopic}},
+	})
+	if err != nil {
+		fmt.Println("Error fetching chainlink logs for block:", block.Number(), err)
+		return
+	}
+	blockLogs := make([]*types.Log, len(logs))
+	for i := range logs {
+		blockLogs[i] = &logs[i]
+	}
+	discoverChainlinkFeedsFromLogs(blockLogs, client)
+}
+
+// Re-verify every feed in the registry (`./helios -verifyFeeds`)
+// Feeds whose description drifted are updated, dead ones are removed
+func VerifyChainlinkFeedRegistry(client *ethclient.Client) {
+	chainlinkFeedRegistry.lock.Lock()
+	loadChainlinkFeedRegistry()
+	addresses := make([]common.Address, 0, len(chainlinkFeedRegistry.feeds))
+	for address := range chainlinkFeedRegistry.feeds {
+		addresses = append(addresses, address)
+	}
+	chainlinkFeedRegistry.lock.Unlock()
+	for _, address := range addresses {
+		if feed := refreshChainlinkFeed(address, client, true); feed != nil {
+			fmt.Println("Verified:", feed.Pair, address.Hex(), "oracles:", len(feed.Oracles))
+		}
+	}
+}
+
+// Number of feeds `oracle` submits to, 0 if it isn't a known chainlink node
+func countChainlinkOracleFeeds(oracle common.Address) int {
+	chainlinkFeedRegistry.lock.Lock()
+	defer chainlinkFeedRegistry.lock.Unlock()
+	loadChainlinkFeedRegistry()
+	feeds := 0
+	for _, feed := range chainlinkFeedRegistry.feeds {
+		if feed.hasOracle(oracle) {
+			feeds++
+		}
+	}
+	return feeds
+}
+
+// Look up a feed by its pair ("ETH/USD") without touching the chain
+func lookupChainlinkFeedByPair(pair string) (*chainlinkFeed, bool) {
+	chainlinkFeedRegistry.lock.Lock()
+	defer chainlinkFeedRegistry.lock.Unlock()
+	loadChainlinkFeedRegistry()
+	for _, feed := range chainlinkFeedRegistry.feeds {
+		if strings.EqualFold(feed.Pair, pair) {
+			return feed, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,140 @@
+package services
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	. "github.com/logrusorgru/aurora"
+	"github.com/taarushv/helios/contracts/chainlinkOCR"
+)
+
+// Chainlink off-chain reporting (OCR) aggregators
+// Instead of one `submit` per oracle, a single transmitter posts a signed report with every oracle's observation
+// The median of the report becomes the new answer as soon as the `transmit` is mined
+var offchainAggregatorAbi, _ = abi.JSON(strings.NewReader(chainlinkOCR.ChainlinkOCRABI))
+
+// transmit(bytes,bytes32[],bytes32[],bytes32)
+var linkOCRTransmit = []byte{0xc9, 0x80, 0x75, 0x39}
+
+// The report is abi.encode(bytes32 rawReportContext, bytes32 rawObservers, int192[] observations)
+var chainlinkOCRReportArguments = func() abi.Arguments {
+	bytes32Type, _ := abi.NewType("bytes32", "", nil)
+	observationsType, _ := abi.NewType("int192[]", "", nil)
+	return abi.Arguments{{Type: bytes32Type}, {Type: bytes32Type}, {Type: observationsType}}
+}()
+
+type chainlinkOCRReport struct {
+	ConfigDigest [16]byte
+	Epoch        uint32
+	Round        uint8
+	Observers    []byte     // Index of the oracle behind each observation
+	Observations []*big.Int // Sorted ascending, enforced on-chain
+	Median       *big.Int
+}
+
+// Unpack the calldata of a `transmit` tx into its report
+func decodeChainlinkOCRReport(data []byte) (*chainlinkOCRReport, error) {
+	var transmitInput struct {
+		Report []byte
+		Rs     [][32]byte
+		Ss     [][32]byte
+		RawVs  [32]byte
+	}
+	if err := offchainAggregatorAbi.Methods["transmit"].Inputs.Unpack(&transmitInput, data[4:]); err != nil {
+		return nil, err
+	}
+	values, err := chainlinkOCRReportArguments.UnpackValues(transmitInput.Report)
+	if err != nil {
+		return nil, err
+	}
+	rawReportContext := values[0].([32]byte)
+	rawObservers := values[1].([32]byte)
+	observations := values[2].([]*big.Int)
+	if len(observations) == 0 || len(observations) > len(rawObservers) {
+		return nil, errors.New("invalid number of observations in OCR report")
+	}
+	// rawReportContext: 11 byte zero padding | 16 byte config digest | 4 byte epoch | 1 byte round
+	report := &chainlinkOCRReport{
+		Epoch:        binary.BigEndian.Uint32(rawReportContext[27:31]),
+		Round:        rawReportContext[31],
+		Observers:    rawObservers[:len(observations)],
+		Observations: observations,
+		// Same pick as the contract, observations[length/2]
+		Median: observations[len(observations)/2],
+	}
+	copy(report.ConfigDigest[:], rawReportContext[11:27])
+	return report, nil
+}
+
+// `transmit` calls only count as oracle updates when they hit a verified OCR aggregator from one of its transmitters
+func isChainlinkOCRTransmit(tx *types.Transaction, client *ethclient.Client) (*chainlinkFeed, bool) {
+	if len(tx.Data()) < 4 || !bytes.Equal(tx.Data()[:4], linkOCRTransmit) {
+		return nil, false
+	}
+	feed, ok := isAuthorisedChainlinkSubmission(tx, client)
+	if !ok || feed.Type != chainlinkFeedTypeOCR {
+		return nil, false
+	}
+	return feed, true
+}
+
+// Classify an OCR transmission, the document has the same shape as legacy `submit` updates
+func handleChainlinkOCRTransmit(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, feed *chainlinkFeed) {
+	report, err := decodeChainlinkOCRReport(tx.Data())
+	if err != nil {
+		fmt.Println("Error decoding chainlink OCR report:", tx.Hash().Hex(), err)
+		if fullMode {
+			handleMiscTx(tx, client, isStealth)
+		}
+		return
+	}
+	OCRInstance, _ := chainlinkOCR.NewChainlinkOCR(*tx.To(), client)
+	pairCurrentPrice, _ := OCRInstance.LatestAnswer(nil)
+	if pairCurrentPrice == nil {
+		pairCurrentPrice = new(big.Int)
+	}
+	latestRound, _ := OCRInstance.LatestRound(nil)
+	if latestRound == nil {
+		latestRound = new(big.Int)
+	}
+	observations := make([]float64, len(report.Observations))
+	for i, observation := range report.Observations {
+		observations[i] = formatChainlinkOraclePrice(observation, feed.Decimals)
+	}
+	final := chainlinkOraclePriceUpdate{
+		Oracle:                         getTxSenderAddress(tx, client),
+		PairDescription:                feed.Pair,
+		RoundId:                        new(big.Int).Add(latestRound, big.NewInt(1)).Int64(),
+		Submission:                     formatChainlinkOraclePrice(report.Median, feed.Decimals),
+		CurrentPrice:                   formatChainlinkOraclePrice(pairCurrentPrice, feed.Decimals),
+		NextPriceIfExecutedInIsolation: formatChainlinkOraclePrice(pairCurrentPrice, feed.Decimals),
+		NextPriceIfExecutedWithOtherOracleTxsInMempool: formatChainlinkOraclePrice(pairCurrentPrice, feed.Decimals),
+		UpdateType:           chainlinkUpdateTypeTransmit,
+		Observations:         observations,
+		OCREpoch:             report.Epoch,
+		OCRRound:             report.Round,
+		RoundSubmissionCount: len(report.Observations),
+	}
+	// Reports have to be newer than the last one transmitted, otherwise the tx reverts with "stale report"
+	latest, err := OCRInstance.LatestTransmissionDetails(nil)
+	if err == nil && (report.Epoch > latest.Epoch || (report.Epoch == latest.Epoch && report.Round > latest.Round)) {
+		final.EligibleToSubmit = true
+		final.UpdatesAnswerIfExecutedInIsolation = true
+		final.NextPriceIfExecutedInIsolation = final.Submission
+		final.NextPriceIfExecutedWithOtherOracleTxsInMempool = final.Submission
+	}
+	fmt.Println()
+	fmt.Println(Green("New TX: Chainlink OCR Transmission"))
+	fmt.Println("Hash: ", tx.Hash().Hex(), " Pair: ", final.PairDescription)
+	fmt.Println("Current Price: ", final.CurrentPrice, " Median: ", final.Submission, " Observations: ", len(observations))
+	if fullMode {
+		handleLinkOracleUpdate(tx, client, isStealth, final)
+	}
+}
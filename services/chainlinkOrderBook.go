@@ -0,0 +1,287 @@
+package services
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	. "github.com/logrusorgru/aurora"
+)
+
+// In-memory "order book" of pending chainlink oracle submissions, per aggregator and round
+// Used to project the answer a feed will settle on once *all* the pending submissions in the mempool are mined
+// Submissions leave the book when they're mined (block stream), replaced, aimed at a round that no longer takes them or older than the TTL below
+
+// Submissions that are still pending after this long were most likely dropped (seconds)
+const chainlinkPendingSubmissionTTL = 600
+
+type pendingOracleSubmission struct {
+	TxHash     common.Hash
+	Oracle     common.Address
+	Nonce      uint64
+	RoundId    uint32
+	Submission *big.Int
+	GasPrice   *big.Int
+	TimeSeen   int64
+}
+
+type chainlinkFeedBook struct {
+	aggregator      common.Address
+	pairDescription string
+	decimals        uint8
+	// roundId => oracle => latest pending submission (an oracle only gets one submission per round)
+	rounds map[uint32]map[common.Address]*pendingOracleSubmission
+	// Last projection we published, nil until the first one
+	projectedAnswer *big.Int
+}
+
+// Feed level event, published every time the projected answer of a feed changes
+type chainlinkNextPriceEvent struct {
+	TimeSeen           int64   `json:"timeSeen"`
+	Aggregator         string  `json:"aggregatorAddress"`
+	PairDescription    string  `json:"oraclePairDescription"`
+	RoundId            int64   `json:"oracleRoundId"` // Latest round the projection includes
+	CurrentPrice       float64 `json:"oracleCurrentPrice"`
+	NextPrice          float64 `json:"oracleNextPrice"`
+	PreviousNextPrice  float64 `json:"oraclePreviousNextPrice"`
+	PendingSubmissions int     `json:"oraclePendingSubmissions"`
+	TriggeredBy        string  `json:"triggeredByTxHash"`
+}
+
+var chainlinkOrderBook = struct {
+	lock  sync.Mutex
+	feeds map[common.Address]*chainlinkFeedBook
+	// txHash => aggregator, to find a submission again once it's mined
+	txs map[common.Hash]common.Address
+}{
+	feeds: make(map[common.Address]*chainlinkFeedBook),
+	txs:   make(map[common.Hash]common.Address),
+}
+
+// Add (or replace) a pending submission and return the projected answer of the feed with everything pending applied
+func trackPendingOracleSubmission(tx *types.Transaction, client *ethclient.Client, fullMode bool, update chainlinkOraclePriceUpdate, submission *big.Int, decimals uint8) *big.Int {
+	aggregator := *tx.To()
+	pending := &pendingOracleSubmission{
+		TxHash:     tx.Hash(),
+		Oracle:     common.HexToAddress(update.Oracle),
+		Nonce:      tx.Nonce(),
+		RoundId:    uint32(update.RoundId),
+		Submission: submission,
+		GasPrice:   tx.GasPrice(),
+		TimeSeen:   time.Now().Unix(),
+	}
+
+	chainlinkOrderBook.lock.Lock()
+	feed, ok := chainlinkOrderBook.feeds[aggregator]
+	if !ok {
+		feed = &chainlinkFeedBook{
+			aggregator:      aggregator,
+			pairDescription: update.PairDescription,
+			decimals:        decimals,
+			rounds:          make(map[uint32]map[common.Address]*pendingOracleSubmission),
+		}
+		chainlinkOrderBook.feeds[aggregator] = feed
+	}
+	// A tx from the same oracle to the same round (gas bump/replacement) supersedes the previous one
+	for _, round := range feed.rounds {
+		if previous, ok := round[pending.Oracle]; ok && previous.Nonce == pending.Nonce {
+			delete(round, pending.Oracle)
+			delete(chainlinkOrderBook.txs, previous.TxHash)
+		}
+	}
+	if feed.rounds[pending.RoundId] == nil {
+		feed.rounds[pending.RoundId] = make(map[common.Address]*pendingOracleSubmission)
+	}
+	feed.rounds[pending.RoundId][pending.Oracle] = pending
+	chainlinkOrderBook.txs[pending.TxHash] = aggregator
+	chainlinkOrderBook.lock.Unlock()
+
+	return feed.updateProjection(client, fullMode, tx.Hash().Hex())
+}
+
+// Called for every mined block, drops the submissions that made it in (or expired) and re-projects the feeds they belonged to
+func removeMinedOracleSubmissions(block *types.Block, client *ethclient.Client, fullMode bool) {
+	chainlinkOrderBook.lock.Lock()
+	touched := make(map[common.Address]*chainlinkFeedBook)
+	for _, tx := range block.Transactions() {
+		aggregator, ok := chainlinkOrderBook.txs[tx.Hash()]
+		if !ok {
+			continue
+		}
+		feed := chainlinkOrderBook.feeds[aggregator]
+		feed.remove(tx.Hash())
+		touched[aggregator] = feed
+	}
+	now := time.Now().Unix()
+	for aggregator, feed := range chainlinkOrderBook.feeds {
+		if feed.expire(now) {
+			touched[aggregator] = feed
+		}
+	}
+	chainlinkOrderBook.lock.Unlock()
+	for _, feed := range touched {
+		feed.updateProjection(client, fullMode, "")
+	}
+}
+
+func (feed *chainlinkFeedBook) remove(txHash common.Hash) {
+	for roundId, round := range feed.rounds {
+		for oracle, pending := range round {
+			if pending.TxHash == txHash {
+				delete(round, oracle)
+			}
+		}
+		if len(round) == 0 {
+			delete(feed.rounds, roundId)
+		}
+	}
+	delete(chainlinkOrderBook.txs, txHash)
+}
+
+// Drop submissions older than the TTL, returns true if anything was dropped. Must be called with the order book lock held
+func (feed *chainlinkFeedBook) expire(now int64) bool {
+	expired := false
+	for roundId, round := range feed.rounds {
+		for oracle, pending := range round {
+			if now-pending.TimeSeen > chainlinkPendingSubmissionTTL {
+				delete(round, oracle)
+				delete(chainlinkOrderBook.txs, pending.TxHash)
+				expired = true
+			}
+		}
+		if len(round) == 0 {
+			delete(feed.rounds, roundId)
+		}
+	}
+	return expired
+}
+
+// Number of submissions still waiting to be mined
+func (feed *chainlinkFeedBook) pendingCount() int {
+	count := 0
+	for _, round := range feed.rounds {
+		count += len(round)
+	}
+	return count
+}
+
+// A pending round and what the aggregator says about it
+type pendingOracleRound struct {
+	roundId uint32
+	reader  common.Address // Any pending oracle works to read the round state
+	state   *chainlinkRoundState
+}
+
+// Replays the pending rounds in order on top of what's already mined and publishes the result if it moved
+// Must be called without the order book lock held, the round states are fetched with the book unlocked
+func (feed *chainlinkFeedBook) updateProjection(client *ethclient.Client, fullMode bool, triggeredBy string) *big.Int {
+	chainlinkOrderBook.lock.Lock()
+	feed.expire(time.Now().Unix())
+	rounds := make([]*pendingOracleRound, 0, len(feed.rounds))
+	for roundId, round := range feed.rounds {
+		for oracle := range round {
+			rounds = append(rounds, &pendingOracleRound{roundId: roundId, reader: oracle})
+			break
+		}
+	}
+	chainlinkOrderBook.lock.Unlock()
+	sort.Slice(rounds, func(i, j int) bool { return rounds[i].roundId < rounds[j].roundId })
+
+	for _, round := range rounds {
+		state, err := getChainlinkRoundState(feed.aggregator, round.reader, round.roundId, client)
+		if err != nil {
+			fmt.Println("Error fetching chainlink round state:", err)
+			continue
+		}
+		round.state = state
+	}
+
+	chainlinkOrderBook.lock.Lock()
+	event, projection := feed.applyRoundStates(rounds, triggeredBy)
+	chainlinkOrderBook.lock.Unlock()
+	if event != nil {
+		fmt.Println()
+		fmt.Println(Green("Chainlink Next Price: " + feed.pairDescription))
+		fmt.Println("Current Price: ", event.CurrentPrice, " Next Price: ", event.NextPrice, " Pending Submissions: ", event.PendingSubmissions)
+		if fullMode {
+			handleChainlinkNextPrice(*event)
+		}
+	}
+	return projection
+}
+
+// Prune the book with the fetched round states and work out the projection, returns the event to publish if it moved
+// Must be called with the order book lock held
+func (feed *chainlinkFeedBook) applyRoundStates(rounds []*pendingOracleRound, triggeredBy string) (*chainlinkNextPriceEvent, *big.Int) {
+	var projection, currentAnswer *big.Int
+	var lastRoundId uint32
+	for _, pendingRound := range rounds {
+		state := pendingRound.state
+		round, ok := feed.rounds[pendingRound.roundId]
+		if state == nil || !ok {
+			continue
+		}
+		currentAnswer = state.LatestAnswer
+		// The reader can't submit and hasn't yet: the round is closed, timed out or too old to report to, so every pending submit to it reverts
+		if !state.Eligible && !state.Submitters[pendingRound.reader] {
+			for _, submission := range round {
+				delete(chainlinkOrderBook.txs, submission.TxHash)
+			}
+			delete(feed.rounds, pendingRound.roundId)
+			continue
+		}
+		// Oracles that already have a mined submission for this round are either mined already or will revert
+		pending := make([]*pendingOracleSubmission, 0, len(round))
+		for oracle, submission := range round {
+			if state.Submitters[oracle] {
+				delete(round, oracle)
+				delete(chainlinkOrderBook.txs, submission.TxHash)
+				continue
+			}
+			pending = append(pending, submission)
+		}
+		if len(round) == 0 {
+			delete(feed.rounds, pendingRound.roundId)
+			continue
+		}
+		// Miners order by gas price, which matters once a round is about to hit max submissions
+		sort.Slice(pending, func(i, j int) bool { return pending[i].GasPrice.Cmp(pending[j].GasPrice) > 0 })
+		submissions := make([]*big.Int, len(pending))
+		for i, submission := range pending {
+			submissions[i] = submission.Submission
+		}
+		if answer, updated := projectChainlinkRoundAnswer(state, submissions); updated {
+			projection = answer
+			lastRoundId = pendingRound.roundId
+		}
+	}
+	if currentAnswer == nil {
+		return nil, nil
+	}
+	if projection == nil {
+		projection = currentAnswer
+	}
+	if feed.projectedAnswer != nil && feed.projectedAnswer.Cmp(projection) == 0 {
+		return nil, projection
+	}
+	event := &chainlinkNextPriceEvent{
+		TimeSeen:           time.Now().Unix(),
+		Aggregator:         feed.aggregator.Hex(),
+		PairDescription:    feed.pairDescription,
+		RoundId:            int64(lastRoundId),
+		CurrentPrice:       formatChainlinkOraclePrice(currentAnswer, feed.decimals),
+		NextPrice:          formatChainlinkOraclePrice(projection, feed.decimals),
+		PendingSubmissions: feed.pendingCount(),
+		TriggeredBy:        triggeredBy,
+	}
+	if feed.projectedAnswer != nil {
+		event.PreviousNextPrice = formatChainlinkOraclePrice(feed.projectedAnswer, feed.decimals)
+	}
+	feed.projectedAnswer = projection
+	return event, projection
+}
@@ -0,0 +1,139 @@
+package services
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/taarushv/helios/contracts/chainlinkACA"
+)
+
+// Helpers to work out what an aggregator will answer once pending submissions land
+// The FluxAggregator doesn't expose a round's submissions, so we rebuild them from SubmissionReceived logs
+
+// ~13s blocks, doubled for slack when converting a round's start time into a log search window
+const chainlinkRoundLookbackSecondsPerBlock = 6
+
+// Don't scan more than this many blocks for a round's submissions (rounds time out well before that)
+const chainlinkRoundMaxLookbackBlocks = 2000
+
+// Snapshot of an in-progress round on the aggregator
+type chainlinkRoundState struct {
+	RoundId        uint32
+	Eligible       bool                    // If the oracle is allowed to submit to this round (the submit reverts otherwise)
+	MinSubmissions uint32                  // Answer is only updated once the round has at least this many submissions
+	MaxSubmissions uint32                  // Round stops accepting submissions after this many
+	Submissions    []*big.Int              // Submissions already mined for this round
+	Submitters     map[common.Address]bool // Oracles that already have a submission mined for this round
+	LatestAnswer   *big.Int
+}
+
+// Pull the round state for `roundId` as seen by `oracle` (oracleRoundState only allows off-chain reads, hence From)
+func getChainlinkRoundState(aggregator common.Address, oracle common.Address, roundId uint32, client *ethclient.Client) (*chainlinkRoundState, error) {
+	ACAInstance, err := chainlinkACA.NewChainlinkACA(aggregator, client)
+	if err != nil {
+		return nil, err
+	}
+	oracleState, err := ACAInstance.OracleRoundState(&bind.CallOpts{From: oracle}, oracle, roundId)
+	if err != nil {
+		return nil, err
+	}
+	minSubmissions, err := ACAInstance.MinSubmissionCount(nil)
+	if err != nil {
+		return nil, err
+	}
+	maxSubmissions, err := ACAInstance.MaxSubmissionCount(nil)
+	if err != nil {
+		return nil, err
+	}
+	latestAnswer, err := ACAInstance.LatestAnswer(nil)
+	if err != nil {
+		return nil, err
+	}
+	state := &chainlinkRoundState{
+		RoundId:        roundId,
+		Eligible:       oracleState.EligibleToSubmit,
+		MinSubmissions: minSubmissions,
+		MaxSubmissions: maxSubmissions,
+		LatestAnswer:   latestAnswer,
+		Submitters:     make(map[common.Address]bool),
+	}
+	// Round hasn't started yet, this submission would be the first one
+	if oracleState.StartedAt == 0 {
+		return state, nil
+	}
+	err = getChainlinkRoundSubmissions(ACAInstance, state, oracleState.StartedAt, client)
+	if err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// Every submission mined so far for a round, found via SubmissionReceived(submission, round, oracle)
+func getChainlinkRoundSubmissions(ACAInstance *chainlinkACA.ChainlinkACA, state *chainlinkRoundState, startedAt uint64, client *ethclient.Client) error {
+	head, err := getLatestHead(client)
+	if err != nil {
+		return err
+	}
+	lookback := uint64(10)
+	if head.Time > startedAt {
+		lookback += (head.Time - startedAt) / chainlinkRoundLookbackSecondsPerBlock
+	}
+	if lookback > chainlinkRoundMaxLookbackBlocks {
+		lookback = chainlinkRoundMaxLookbackBlocks
+	}
+	start := uint64(0)
+	if head.Number.Uint64() > lookback {
+		start = head.Number.Uint64() - lookback
+	}
+	iterator, err := ACAInstance.FilterSubmissionReceived(&bind.FilterOpts{Start: start}, nil, []uint32{state.RoundId}, nil)
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+	for iterator.Next() {
+		if iterator.Event.Raw.Removed {
+			continue
+		}
+		state.Submissions = append(state.Submissions, iterator.Event.Submission)
+		state.Submitters[iterator.Event.Oracle] = true
+	}
+	return iterator.Error()
+}
+
+// Median as computed on-chain by the aggregator (Median.calculateInplace)
+// Even sized lists average the two middle values, rounding towards zero (SignedSafeMath.avg)
+func chainlinkMedian(submissions []*big.Int) *big.Int {
+	if len(submissions) == 0 {
+		return nil
+	}
+	sorted := make([]*big.Int, len(submissions))
+	copy(sorted, submissions)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Cmp(sorted[j]) < 0 })
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return new(big.Int).Set(sorted[middle])
+	}
+	// big.Int.Quo truncates towards zero, same as solidity's signed division
+	sum := new(big.Int).Add(sorted[middle-1], sorted[middle])
+	return sum.Quo(sum, big.NewInt(2))
+}
+
+// Project the answer after `newSubmissions` are added to the round
+// Returns the answer and whether the round's answer gets updated at all (min submissions reached and round still open)
+func projectChainlinkRoundAnswer(state *chainlinkRoundState, newSubmissions []*big.Int) (*big.Int, bool) {
+	submissions := append([]*big.Int{}, state.Submissions...)
+	for _, submission := range newSubmissions {
+		// Round is closed once max submissions are in, anything after that reverts
+		if state.MaxSubmissions != 0 && uint32(len(submissions)) >= state.MaxSubmissions {
+			break
+		}
+		submissions = append(submissions, submission)
+	}
+	if len(submissions) == len(state.Submissions) || uint32(len(submissions)) < state.MinSubmissions {
+		return state.LatestAnswer, false
+	}
+	return chainlinkMedian(submissions), true
+}
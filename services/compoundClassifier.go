@@ -0,0 +1,264 @@
+package services
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	. "github.com/logrusorgru/aurora"
+	"github.com/taarushv/helios/contracts/compound"
+)
+
+// Compound cToken calls (mint, redeem, borrow, repayBorrow, liquidateBorrow) and Comptroller calls (enterMarkets, exitMarket, claimComp)
+// Markets are discovered through Comptroller.getAllMarkets(), so new listings get picked up without code changes
+// cETH takes ETH through msg.value, so its payable variants have no amount argument
+var cTokenAbi, _ = abi.JSON(strings.NewReader(compound.CTokenABI))
+var compoundComptrollerAbi, _ = abi.JSON(strings.NewReader(compound.ComptrollerABI))
+
+const compoundProtocolName = "Compound"
+
+var compoundComptrollerAddress = common.HexToAddress("0x3d9819210A31b4961b30EF54bE2aeD79B9c9Cd3B")
+
+var compTokenAddress = common.HexToAddress("0xc00e94Cb662C3520282E6f5717214004A7f26888")
+
+// How often the market list is refreshed from the Comptroller
+const compoundMarketsRefreshInterval = 60 * 60
+
+// Wait this long before trying again when a refresh fails
+const compoundMarketsRetryInterval = 60
+
+// cToken => underlying (ethPlaceholderAddress for cETH)
+var compoundMarketRegistry = struct {
+	lock       sync.Mutex
+	markets    map[common.Address]common.Address
+	updatedAt  int64
+	retryAt    int64
+	refreshing bool
+}{
+	markets: make(map[common.Address]common.Address),
+}
+
+// Rebuild the market mapping from the Comptroller, must be called without the registry lock held
+// The RPCs run without the lock, lookups keep getting the previous mapping in the meantime
+func refreshCompoundMarkets(client *ethclient.Client) {
+	defer func() {
+		compoundMarketRegistry.lock.Lock()
+		compoundMarketRegistry.refreshing = false
+		compoundMarketRegistry.lock.Unlock()
+	}()
+	comptrollerInstance, err := compound.NewComptroller(compoundComptrollerAddress, client)
+	if err != nil {
+		return
+	}
+	cTokens, err := comptrollerInstance.GetAllMarkets(nil)
+	if err != nil || len(cTokens) == 0 {
+		fmt.Println("Error refreshing compound markets, keeping the previous mapping")
+		return
+	}
+	compoundMarketRegistry.lock.Lock()
+	previous := compoundMarketRegistry.markets
+	compoundMarketRegistry.lock.Unlock()
+	markets := make(map[common.Address]common.Address)
+	for _, cToken := range cTokens {
+		// Underlying never changes, only look up new markets
+		if underlying, ok := previous[cToken]; ok {
+			markets[cToken] = underlying
+			continue
+		}
+		cTokenInstance, _ := compound.NewCToken(cToken, client)
+		underlying, err := cTokenInstance.Underlying(nil)
+		if err != nil {
+			// cETH has no underlying()
+			underlying = ethPlaceholderAddress
+		}
+		markets[cToken] = underlying
+	}
+	compoundMarketRegistry.lock.Lock()
+	compoundMarketRegistry.markets = markets
+	compoundMarketRegistry.updatedAt = time.Now().Unix()
+	compoundMarketRegistry.lock.Unlock()
+}
+
+// Claim the refresh if one is due, must be called with the registry lock held
+func isCompoundMarketsRefreshDue() bool {
+	now := time.Now().Unix()
+	if compoundMarketRegistry.refreshing || compoundMarketRegistry.updatedAt+compoundMarketsRefreshInterval >= now || compoundMarketRegistry.retryAt > now {
+		return false
+	}
+	compoundMarketRegistry.refreshing = true
+	compoundMarketRegistry.retryAt = now + compoundMarketsRetryInterval
+	return true
+}
+
+// Load the markets before the first tx comes in, later refreshes happen in the background
+func preloadCompoundMarkets(client *ethclient.Client) {
+	compoundMarketRegistry.lock.Lock()
+	due := isCompoundMarketsRefreshDue()
+	compoundMarketRegistry.lock.Unlock()
+	if due {
+		refreshCompoundMarkets(client)
+	}
+}
+
+func lookupCompoundMarket(cToken common.Address, client *ethclient.Client) (common.Address, bool) {
+	compoundMarketRegistry.lock.Lock()
+	defer compoundMarketRegistry.lock.Unlock()
+	if isCompoundMarketsRefreshDue() {
+		go refreshCompoundMarkets(client)
+	}
+	underlying, ok := compoundMarketRegistry.markets[cToken]
+	return underlying, ok
+}
+
+func isCompoundMarket(tx *types.Transaction, client *ethclient.Client) bool {
+	if *tx.To() == compoundComptrollerAddress {
+		return true
+	}
+	_, ok := lookupCompoundMarket(*tx.To(), client)
+	return ok
+}
+
+func handleCompoundAction(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+	cToken := *tx.To()
+	if cToken == compoundComptrollerAddress {
+		handleCompoundComptrollerCall(tx, client, isStealth, fullMode)
+		return
+	}
+	underlying, _ := lookupCompoundMarket(cToken, client)
+	method, err := cTokenAbi.MethodById(tx.Data()[:4])
+	if err != nil || method.IsConstant() {
+		// accrueInterest, transferFrom, comptroller hooks etc
+		if fullMode {
+			handleMiscTx(tx, client, isStealth)
+		}
+		return
+	}
+	args := make(map[string]interface{})
+	if err := method.Inputs.UnpackIntoMap(args, tx.Data()[4:]); err != nil {
+		fmt.Println("Error decoding compound call:", tx.Hash().Hex(), err)
+		if fullMode {
+			handleMiscTx(tx, client, isStealth)
+		}
+		return
+	}
+	sender := getTxSenderAddress(tx, client)
+	// Amount argument, or msg.value for the cETH variants
+	amount := tx.Value()
+	for _, name := range []string{"mintAmount", "redeemTokens", "redeemAmount", "borrowAmount", "repayAmount"} {
+		if value, ok := args[name].(*big.Int); ok {
+			amount = value
+		}
+	}
+	borrower := common.HexToAddress(sender)
+	if value, ok := args["borrower"].(common.Address); ok {
+		borrower = value
+	}
+	if method.RawName == "liquidateBorrow" {
+		collateralMarket := args["cTokenCollateral"].(common.Address)
+		collateral, _ := lookupCompoundMarket(collateralMarket, client)
+		final := lendingLiquidation{
+			Protocol:              compoundProtocolName,
+			Liquidator:            sender,
+			Borrower:              borrower.Hex(),
+			DebtAsset:             underlying.Hex(),
+			DebtAssetSymbol:       getAggregatorTokenSymbol(underlying, client),
+			RepayAmount:           formatLendingAmount(amount, underlying, client),
+			CollateralAsset:       collateral.Hex(),
+			CollateralAssetSymbol: getAggregatorTokenSymbol(collateral, client),
+			DebtMarket:            cToken.Hex(),
+			CollateralMarket:      collateralMarket.Hex(),
+		}
+		fmt.Println()
+		fmt.Println(BrightRed("New TX: Compound Liquidation"))
+		fmt.Println("Hash: ", tx.Hash().Hex(), " Borrower: ", final.Borrower, " Repay: ", final.RepayAmount, final.DebtAssetSymbol, " Seize: ", final.CollateralAssetSymbol)
+		if fullMode {
+			handleLiquidation(tx, client, isStealth, final)
+		}
+		return
+	}
+	var action string
+	asset := underlying
+	switch method.RawName {
+	case "mint":
+		action = lendingActionMint
+	case "redeem":
+		// Amount is in cTokens
+		action, asset = lendingActionRedeem, cToken
+	case "redeemUnderlying":
+		action = lendingActionRedeem
+	case "borrow":
+		action = lendingActionBorrow
+	case "repayBorrow", "repayBorrowBehalf":
+		action = lendingActionRepay
+	}
+	final := lendingAction{
+		Protocol:     compoundProtocolName,
+		Action:       action,
+		Market:       cToken.Hex(),
+		Assets:       []string{asset.Hex()},
+		AssetSymbols: []string{getAggregatorTokenSymbol(asset, client)},
+		Amounts:      []float64{formatLendingAmount(amount, asset, client)},
+		FullAmount:   amount.Cmp(maxUint256) == 0,
+		Borrower:     borrower.Hex(),
+	}
+	fmt.Println()
+	fmt.Println(Cyan("New TX: Compound " + strings.Title(final.Action)))
+	fmt.Println("Hash: ", tx.Hash().Hex(), " Amount: ", final.Amounts[0], final.AssetSymbols[0], " Account: ", final.Borrower)
+	if fullMode {
+		handleLendingAction(tx, client, isStealth, final)
+	}
+}
+
+// Comptroller calls: which markets back an account's borrows (enterMarkets/exitMarket) and COMP claims
+// There's no amount until the tx executes, so these documents have empty `amounts`
+func handleCompoundComptrollerCall(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+	method, err := compoundComptrollerAbi.MethodById(tx.Data()[:4])
+	args := make(map[string]interface{})
+	if err != nil || method.IsConstant() || method.Inputs.UnpackIntoMap(args, tx.Data()[4:]) != nil {
+		if fullMode {
+			handleMiscTx(tx, client, isStealth)
+		}
+		return
+	}
+	final := lendingAction{
+		Protocol:     compoundProtocolName,
+		Market:       compoundComptrollerAddress.Hex(),
+		Assets:       []string{},
+		AssetSymbols: []string{},
+		Amounts:      []float64{},
+		Borrower:     getTxSenderAddress(tx, client),
+	}
+	var markets []common.Address
+	switch method.RawName {
+	case "enterMarkets":
+		final.Action = lendingActionEnterMarkets
+		markets = args["cTokens"].([]common.Address)
+	case "exitMarket":
+		final.Action = lendingActionExitMarket
+		markets = []common.Address{args["cTokenAddress"].(common.Address)}
+	case "claimComp":
+		final.Action = lendingActionClaimComp
+		final.Borrower = args["holder"].(common.Address).Hex()
+		markets = []common.Address{compTokenAddress}
+	}
+	for _, market := range markets {
+		asset := market
+		if underlying, ok := lookupCompoundMarket(market, client); ok {
+			asset = underlying
+		}
+		final.Assets = append(final.Assets, asset.Hex())
+		final.AssetSymbols = append(final.AssetSymbols, getAggregatorTokenSymbol(asset, client))
+	}
+	fmt.Println()
+	fmt.Println(Cyan("New TX: Compound " + final.Action))
+	fmt.Println("Hash: ", tx.Hash().Hex(), " Assets: ", final.AssetSymbols, " Account: ", final.Borrower)
+	if fullMode {
+		handleLendingAction(tx, client, isStealth, final)
+	}
+}
@@ -0,0 +1,156 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	. "github.com/logrusorgru/aurora"
+	"github.com/taarushv/helios/contracts/curve"
+)
+
+// Curve pool swaps, indexed as "poolTrade" with the same schema as uniswap trades (venue "Curve")
+// Calls only carry coin indices, so the tokens are resolved through coins(i)/underlying_coins(i) and cached per pool
+// Older pools take int128 indices, newer (and crypto) pools take uint256, each pool answers to one of the two
+var curvePoolAbi, _ = abi.JSON(strings.NewReader(curve.CurvePoolABI))
+
+const curveVenueName = "Curve"
+
+var curveExchange = []byte{0x3d, 0xf0, 0x21, 0x24}               // exchange(int128,int128,uint256,uint256)
+var curveExchangeUnderlying = []byte{0xa6, 0x41, 0x7e, 0xd6}     // exchange_underlying(int128,int128,uint256,uint256)
+var curveExchangeUint = []byte{0x5b, 0x41, 0xb9, 0x08}           // exchange(uint256,uint256,uint256,uint256)
+var curveExchangeUnderlyingUint = []byte{0x65, 0xb2, 0x48, 0x9b} // exchange_underlying(uint256,uint256,uint256,uint256)
+
+type curveCoinKey struct {
+	Pool       common.Address
+	Index      int64
+	Underlying bool
+}
+
+// Coins never change for a deployed pool, so entries are kept for good
+var curveCoinCache = struct {
+	lock  sync.Mutex
+	coins map[curveCoinKey]common.Address
+	// Contracts that don't answer coins(), so we don't query them on every tx
+	rejected map[common.Address]bool
+}{
+	coins:    make(map[curveCoinKey]common.Address),
+	rejected: make(map[common.Address]bool),
+}
+
+type curveExchangeInput struct {
+	I     *big.Int
+	J     *big.Int
+	Dx    *big.Int
+	MinDy *big.Int
+}
+
+func isCurveExchange(tx *types.Transaction) bool {
+	selector := tx.Data()[:4]
+	return bytes.Equal(selector, curveExchange) || bytes.Equal(selector, curveExchangeUnderlying) ||
+		bytes.Equal(selector, curveExchangeUint) || bytes.Equal(selector, curveExchangeUnderlyingUint)
+}
+
+// coins(i), trying the uint256 variant before the int128 one
+func fetchCurveCoin(poolInstance *curve.CurvePool, index *big.Int) (common.Address, error) {
+	coin, err := poolInstance.Coins(nil, index)
+	if err != nil {
+		coin, err = poolInstance.Coins0(nil, index)
+	}
+	return coin, err
+}
+
+// Lending pools (compound, y, busd etc) list their underlying tokens in underlying_coins
+// Metapools trade against their base pool instead: index 0 is the meta coin, the rest are the base pool's coins
+func fetchCurveUnderlyingCoin(poolInstance *curve.CurvePool, index *big.Int) (common.Address, error) {
+	coin, err := poolInstance.UnderlyingCoins(nil, index)
+	if err == nil {
+		return coin, nil
+	}
+	if coin, err = poolInstance.UnderlyingCoins0(nil, index); err == nil {
+		return coin, nil
+	}
+	if index.Sign() == 0 {
+		return fetchCurveCoin(poolInstance, index)
+	}
+	return poolInstance.BaseCoins(nil, new(big.Int).Sub(index, big.NewInt(1)))
+}
+
+func getCurveCoin(pool common.Address, index *big.Int, underlying bool, client *ethclient.Client) (common.Address, error) {
+	key := curveCoinKey{Pool: pool, Index: index.Int64(), Underlying: underlying}
+	curveCoinCache.lock.Lock()
+	defer curveCoinCache.lock.Unlock()
+	if curveCoinCache.rejected[pool] {
+		return common.Address{}, fmt.Errorf("%s is not a curve pool", pool.Hex())
+	}
+	if coin, ok := curveCoinCache.coins[key]; ok {
+		return coin, nil
+	}
+	poolInstance, err := curve.NewCurvePool(pool, client)
+	if err != nil {
+		return common.Address{}, err
+	}
+	var coin common.Address
+	if underlying {
+		coin, err = fetchCurveUnderlyingCoin(poolInstance, index)
+	} else {
+		coin, err = fetchCurveCoin(poolInstance, index)
+	}
+	if err != nil || coin == (common.Address{}) {
+		// A pool always has coins(0), anything that doesn't isn't a pool
+		if _, err := fetchCurveCoin(poolInstance, big.NewInt(0)); err != nil {
+			curveCoinCache.rejected[pool] = true
+		}
+		return common.Address{}, fmt.Errorf("no coin %d on %s: %v", key.Index, pool.Hex(), err)
+	}
+	curveCoinCache.coins[key] = coin
+	return coin, nil
+}
+
+func handleCurveExchange(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+	selector := tx.Data()[:4]
+	underlying := bytes.Equal(selector, curveExchangeUnderlying) || bytes.Equal(selector, curveExchangeUnderlyingUint)
+	var input curveExchangeInput
+	method, err := curvePoolAbi.MethodById(selector)
+	if err == nil {
+		err = method.Inputs.Unpack(&input, tx.Data()[4:])
+	}
+	var tokenIn, tokenOut common.Address
+	if err == nil {
+		tokenIn, err = getCurveCoin(*tx.To(), input.I, underlying, client)
+	}
+	if err == nil {
+		tokenOut, err = getCurveCoin(*tx.To(), input.J, underlying, client)
+	}
+	if err != nil {
+		fmt.Println("Error decoding curve exchange:", tx.Hash().Hex(), err)
+		if fullMode {
+			handleMiscTx(tx, client, isStealth)
+		}
+		return
+	}
+	final := UniswapTradeFinal{
+		Venue:             curveVenueName,
+		AmountIn:          formatAggregatorAmount(input.Dx, tokenIn, client),
+		AmountOutMin:      formatAggregatorAmount(input.MinDy, tokenOut, client),
+		Path:              []string{tokenIn.Hex(), tokenOut.Hex()},
+		To:                getTxSenderAddress(tx, client),
+		OutputTokenSymbol: getAggregatorTokenSymbol(tokenOut, client),
+		OutputTokenName:   getAggregatorTokenName(tokenOut, client),
+		Pairs:             []string{tx.To().Hex()},
+		CoinIndices:       []int64{input.I.Int64(), input.J.Int64()},
+		Underlying:        underlying,
+	}
+	fmt.Println()
+	fmt.Println(Red("New TX: Curve Trade"))
+	fmt.Println("Hash: ", tx.Hash().Hex(), " Amount: ", final.AmountIn, getAggregatorTokenSymbol(tokenIn, client), " For: ", final.OutputTokenSymbol, " Min Return: ", final.AmountOutMin)
+	if fullMode {
+		handlePoolFinalTrade(tx, client, isStealth, final)
+	}
+}
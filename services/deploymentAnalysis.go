@@ -0,0 +1,290 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	. "github.com/logrusorgru/aurora"
+)
+
+// Static analysis of contract deployments (txs without a recipient), new token launches and bot contracts show up here first
+// Everything is read off the init code: the CREATE address, the selectors in the dispatcher, which standards those selectors add up to
+// and best effort hints at the constructor arguments (appended after the runtime code + metadata)
+// Once the tx is mined the deployed runtime code replaces the guesses (code hash, exact selectors)
+
+const (
+	contractStandardProxy         = "proxy"
+	contractStandardMinimalProxy  = "minimalProxy" // EIP-1167 clone
+	contractStandardUniswapV2Pair = "uniswapV2Pair"
+)
+
+const (
+	opEQ           = 0x14
+	opPUSH1        = 0x60
+	opPUSH4        = 0x63
+	opPUSH32       = 0x7f
+	opDELEGATECALL = 0xf4
+)
+
+// Selectors that make up each standard, every one of them has to be in the dispatcher
+var contractStandardSelectors = []struct {
+	Standard  string
+	Selectors []string
+}{
+	{tokenStandardERC20, []string{"a9059cbb", "095ea7b3", "23b872dd", "70a08231", "18160ddd", "dd62ed3e"}},
+	{tokenStandardERC721, []string{"6352211e", "42842e0e", "a22cb465", "081812fc", "70a08231"}},
+	{tokenStandardERC1155, []string{"f242432a", "2eb2c2d6", "4e1273f4", "a22cb465"}},
+	{contractStandardUniswapV2Pair, []string{"0902f1ac", "022c0d9f", "0dfe1681", "d21220a7"}},
+}
+
+// EIP-1167 runtime code, the implementation address sits between the prefix and suffix
+var minimalProxyPrefix = []byte{0x36, 0x3d, 0x3d, 0x37, 0x3d, 0x3d, 0x3d, 0x36, 0x3d, 0x73}
+var minimalProxySuffix = []byte{0x5a, 0xf4, 0x3d, 0x82, 0x80, 0x3e, 0x90, 0x3d, 0x91, 0x60, 0x2b, 0x57, 0xfd, 0x5b, 0xf3}
+
+// Solidity CBOR metadata starts with one of these (ipfs for recent compilers, bzzr0/bzzr1 for older ones)
+var solidityMetadataMarkers = [][]byte{
+	{0xa2, 0x64, 'i', 'p', 'f', 's'},
+	{0xa2, 0x65, 'b', 'z', 'z', 'r'},
+	{0xa1, 0x65, 'b', 'z', 'z', 'r'},
+}
+
+type contractDeployment struct {
+	ContractAddress            string   `json:"contractAddress"` // CREATE address from sender + nonce
+	Deployer                   string   `json:"deployer"`
+	InitCodeSize               int      `json:"initCodeSize"`
+	Selectors                  []string `json:"selectors"` // From the init code until mined, then from the deployed code
+	Standards                  []string `json:"standards"`
+	MinimalProxyImplementation string   `json:"minimalProxyImplementation"`
+	ConstructorArgHints        []string `json:"constructorArgHints"`
+	// Filled in once mined
+	Deployed        bool   `json:"deployed"`
+	CodeHash        string `json:"codeHash"`
+	RuntimeCodeSize int    `json:"runtimeCodeSize"`
+	Fingerprint     string `json:"fingerprint"` // Normalised code hash, shared by redeploys of the same contract (bytecodeFingerprint.go)
+}
+
+// Walk the opcodes (skipping PUSH data) and collect the PUSH4 constants compared with EQ, that's how both solc and vyper dispatch
+func extractSelectors(code []byte) []string {
+	var selectors []string
+	seen := make(map[string]bool)
+	for i := 0; i < len(code); i++ {
+		op := code[i]
+		if op < opPUSH1 || op > opPUSH32 {
+			continue
+		}
+		size := int(op-opPUSH1) + 1
+		if op == opPUSH4 && i+5 < len(code) && code[i+5] == opEQ {
+			selector := hex.EncodeToString(code[i+1 : i+5])
+			if !seen[selector] && selector != "ffffffff" && selector != "00000000" {
+				seen[selector] = true
+				selectors = append(selectors, selector)
+			}
+		}
+		i += size
+	}
+	return selectors
+}
+
+// Whether `opcode` shows up as an instruction (and not inside PUSH data)
+func containsOpcode(code []byte, opcode byte) bool {
+	for i := 0; i < len(code); i++ {
+		if code[i] == opcode {
+			return true
+		}
+		if code[i] >= opPUSH1 && code[i] <= opPUSH32 {
+			i += int(code[i]-opPUSH1) + 1
+		}
+	}
+	return false
+}
+
+// Index right after the solidity metadata (CBOR + 2 byte length), -1 if there isn't any
+func findMetadataEnd(code []byte) int {
+	for _, marker := range solidityMetadataMarkers {
+		start := bytes.LastIndex(code, marker)
+		if start < 0 {
+			continue
+		}
+		for end := start + len(marker); end+2 <= len(code) && end-start <= 128; end++ {
+			if int(code[end])<<8|int(code[end+1]) == end-start {
+				return end + 2
+			}
+		}
+	}
+	return -1
+}
+
+// Implementation of an EIP-1167 clone, zero if the code doesn't contain one
+func findMinimalProxyImplementation(code []byte) common.Address {
+	start := bytes.Index(code, minimalProxyPrefix)
+	if start < 0 || start+len(minimalProxyPrefix)+20+len(minimalProxySuffix) > len(code) {
+		return common.Address{}
+	}
+	implementation := code[start+len(minimalProxyPrefix) : start+len(minimalProxyPrefix)+20]
+	if !bytes.HasPrefix(code[start+len(minimalProxyPrefix)+20:], minimalProxySuffix) {
+		return common.Address{}
+	}
+	return common.BytesToAddress(implementation)
+}
+
+func detectContractStandards(code []byte, selectors []string) []string {
+	available := make(map[string]bool)
+	for _, selector := range selectors {
+		available[selector] = true
+	}
+	standards := []string{}
+	for _, standard := range contractStandardSelectors {
+		matched := true
+		for _, selector := range standard.Selectors {
+			matched = matched && available[selector]
+		}
+		if matched {
+			standards = append(standards, standard.Standard)
+		}
+	}
+	if findMinimalProxyImplementation(code) != (common.Address{}) {
+		return append(standards, contractStandardMinimalProxy)
+	}
+	// Delegating contracts that either use a standard implementation slot or expose upgradeTo
+	if containsOpcode(code, opDELEGATECALL) {
+		upgradeable := available[hex.EncodeToString(proxyUpgradeTo)] || available[hex.EncodeToString(proxyUpgradeToAndCall)]
+		for _, slot := range []common.Hash{eip1967ImplementationSlot, eip1967BeaconSlot, eip1822ProxiableSlot, ozLegacyImplementationSlot} {
+			upgradeable = upgradeable || bytes.Contains(code, slot.Bytes())
+		}
+		if upgradeable {
+			standards = append(standards, contractStandardProxy)
+		}
+	}
+	return standards
+}
+
+// Guess what each 32 byte word of the constructor args is, there's no ABI to go by
+func getConstructorArgHints(args []byte) []string {
+	hints := []string{}
+	for offset := 0; offset+32 <= len(args); offset += 32 {
+		word := args[offset : offset+32]
+		value := new(big.Int).SetBytes(word)
+		switch {
+		case value.Sign() == 0:
+			hints = append(hints, "uint256 0")
+		case bytes.Equal(word[:12], make([]byte, 12)) && value.BitLen() > 128:
+			hints = append(hints, "address "+common.BytesToAddress(word).Hex())
+		case value.BitLen() <= 64 && value.Uint64()%32 == 0 && value.Uint64() < uint64(len(args)) && value.Uint64() > uint64(offset):
+			// Points further into the args, likely the head of a string/bytes/array
+			hints = append(hints, fmt.Sprintf("offset %d", value.Uint64()))
+		case value.BitLen() <= 128:
+			hints = append(hints, "uint256 "+value.String())
+		default:
+			hints = append(hints, "bytes32 0x"+hex.EncodeToString(word))
+		}
+	}
+	if len(args)%32 != 0 {
+		hints = append(hints, fmt.Sprintf("%d trailing bytes", len(args)%32))
+	}
+	return hints
+}
+
+func analyzeContractDeployment(tx *types.Transaction, client *ethclient.Client) contractDeployment {
+	initCode := tx.Data()
+	sender := common.HexToAddress(getTxSenderAddress(tx, client))
+	final := contractDeployment{
+		ContractAddress:     crypto.CreateAddress(sender, tx.Nonce()).Hex(),
+		Deployer:            sender.Hex(),
+		InitCodeSize:        len(initCode),
+		Selectors:           extractSelectors(initCode),
+		ConstructorArgHints: []string{},
+	}
+	final.Standards = detectContractStandards(initCode, final.Selectors)
+	if implementation := findMinimalProxyImplementation(initCode); implementation != (common.Address{}) {
+		final.MinimalProxyImplementation = implementation.Hex()
+	}
+	if end := findMetadataEnd(initCode); end >= 0 {
+		final.ConstructorArgHints = getConstructorArgHints(initCode[end:])
+	}
+	return final
+}
+
+// Swap the init code guesses for the deployed code, false if there's nothing at the address (not mined yet, or the constructor reverted)
+func updateDeploymentWithRuntimeCode(final *contractDeployment, client *ethclient.Client) bool {
+	code, err := client.CodeAt(context.Background(), common.HexToAddress(final.ContractAddress), nil)
+	if err != nil || len(code) == 0 {
+		return false
+	}
+	final.Deployed = true
+	final.CodeHash = crypto.Keccak256Hash(code).Hex()
+	final.RuntimeCodeSize = len(code)
+	final.Selectors = extractSelectors(code)
+	final.Standards = detectContractStandards(code, final.Selectors)
+	final.Fingerprint = registerDeployedContract(common.HexToAddress(final.ContractAddress), common.HexToAddress(final.Deployer), code)
+	return true
+}
+
+func handleNewContract(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+	final := analyzeContractDeployment(tx, client)
+	if isStealth {
+		updateDeploymentWithRuntimeCode(&final, client)
+	}
+	fmt.Println()
+	fmt.Println(White("New TX: Contract Deployment"))
+	fmt.Println("Hash: ", tx.Hash().Hex(), " Address: ", final.ContractAddress, " Standards: ", strings.Join(final.Standards, ","), " Selectors: ", len(final.Selectors))
+	if fullMode {
+		handleContractDeployment(tx, client, isStealth, final)
+	}
+}
+
+// The fields of contractDeployment that change once the code is on-chain, for partial document updates
+type minedDeployment struct {
+	ContractAddress string   `json:"contractAddress"`
+	Deployed        bool     `json:"deployed"`
+	CodeHash        string   `json:"codeHash"`
+	RuntimeCodeSize int      `json:"runtimeCodeSize"`
+	Selectors       []string `json:"selectors"`
+	Standards       []string `json:"standards"`
+	Fingerprint     string   `json:"fingerprint"`
+}
+
+// nil unless the tx is a mined deployment
+func getMinedDeployment(txHash string, client *ethclient.Client) *minedDeployment {
+	receipt, err := client.TransactionReceipt(context.Background(), common.HexToHash(txHash))
+	if err != nil || receipt.ContractAddress == (common.Address{}) {
+		return nil
+	}
+	final := contractDeployment{ContractAddress: receipt.ContractAddress.Hex()}
+	if tx, _, err := client.TransactionByHash(context.Background(), receipt.TxHash); err == nil {
+		final.Deployer = getTxSenderAddress(tx, client)
+	}
+	updateDeploymentWithRuntimeCode(&final, client)
+	return &minedDeployment{
+		ContractAddress: final.ContractAddress,
+		Deployed:        final.Deployed,
+		CodeHash:        final.CodeHash,
+		RuntimeCodeSize: final.RuntimeCodeSize,
+		Selectors:       final.Selectors,
+		Standards:       final.Standards,
+		Fingerprint:     final.Fingerprint,
+	}
+}
+
+// Mined deployments are printed with their deployed code from the block stream, the documents aren't updated until mined blocks get indexed (TxMinedUpdate)
+func printMinedDeployments(block *types.Block, client *ethclient.Client) {
+	for _, tx := range block.Transactions() {
+		if tx.To() != nil {
+			continue
+		}
+		deployment := getMinedDeployment(tx.Hash().Hex(), client)
+		if deployment == nil || !deployment.Deployed {
+			continue
+		}
+		fmt.Println()
+		fmt.Println(White("Mined: Contract Deployment"))
+		fmt.Println("Hash: ", tx.Hash().Hex(), " Address: ", deployment.ContractAddress, " Code Hash: ", deployment.CodeHash, " Standards: ", strings.Join(deployment.Standards, ","), " Selectors: ", len(deployment.Selectors))
+	}
+}
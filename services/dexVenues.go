@@ -0,0 +1,114 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Registry of V2 style exchanges (Uniswap V2 and its forks), they share the router ABI and only differ in addresses
+// The factory + init code hash are what we need to derive pair addresses for reserves lookups
+// Extra venues can be added in ./data/dex-venues.json (override with DEX_VENUES_PATH in .env), entries there win over the defaults
+
+const defaultDexVenuesPath = "./data/dex-venues.json"
+
+type dexVenue struct {
+	Name         string `json:"name"` // "Uniswap V2", "SushiSwap"
+	Router       string `json:"router"`
+	Factory      string `json:"factory"`
+	InitCodeHash string `json:"initCodeHash"` // Leave empty to look pairs up via factory.getPair instead of CREATE2
+}
+
+var defaultDexVenues = []*dexVenue{
+	{
+		Name:         "Uniswap V2",
+		Router:       "0x7a250d5630B4cF539739dF2C5dAcb4c659F2488D",
+		Factory:      "0x5C69bEe701ef814a2B6a3EDD4B1652CB9cc5aA6f",
+		InitCodeHash: "0x96e8ac4277198ff8b6f785478aa9a39f403cb768dd02cbee326c3e7da348845f",
+	},
+	{
+		// Router01, deprecated but still used by a few bots
+		Name:         "Uniswap V2",
+		Router:       "0xf164fC0Ec4E93095b804a4795bBe1e041497b92a",
+		Factory:      "0x5C69bEe701ef814a2B6a3EDD4B1652CB9cc5aA6f",
+		InitCodeHash: "0x96e8ac4277198ff8b6f785478aa9a39f403cb768dd02cbee326c3e7da348845f",
+	},
+	{
+		Name:         "SushiSwap",
+		Router:       "0xd9e1cE17f2641f24aE83637ab66a2cca9C378B9F",
+		Factory:      "0xC0AEe478e3658e2610c5F7A4A2E1777cE9e4f2Ac",
+		InitCodeHash: "0xe18a34eb0e04b04f7a0ac29a6e80748dca96319b42c520bfa2c7f0e5fcf5ac0d",
+	},
+}
+
+var dexVenueRegistry = struct {
+	once   sync.Once
+	venues map[common.Address]*dexVenue
+}{}
+
+func getDexVenuesPath() string {
+	if path := os.Getenv("DEX_VENUES_PATH"); path != "" {
+		return path
+	}
+	return defaultDexVenuesPath
+}
+
+func loadDexVenues() {
+	venues := make(map[common.Address]*dexVenue)
+	for _, venue := range defaultDexVenues {
+		venues[common.HexToAddress(venue.Router)] = venue
+	}
+	buf, err := ioutil.ReadFile(getDexVenuesPath())
+	if err == nil {
+		var extra []*dexVenue
+		if err := json.Unmarshal(buf, &extra); err != nil {
+			fmt.Println("Error parsing dex venues:", err)
+		}
+		for _, venue := range extra {
+			if !common.IsHexAddress(venue.Router) || !common.IsHexAddress(venue.Factory) {
+				fmt.Println("Skipping dex venue with invalid addresses:", venue.Name)
+				continue
+			}
+			venues[common.HexToAddress(venue.Router)] = venue
+		}
+	}
+	dexVenueRegistry.venues = venues
+}
+
+// Look up the venue behind a router address
+func lookupDexVenue(router common.Address) (*dexVenue, bool) {
+	dexVenueRegistry.once.Do(loadDexVenues)
+	venue, ok := dexVenueRegistry.venues[router]
+	return venue, ok
+}
+
+// Look up a venue by its factory (pairs only know their factory, not the router that was used)
+func lookupDexVenueByFactory(factory common.Address) (*dexVenue, bool) {
+	dexVenueRegistry.once.Do(loadDexVenues)
+	for _, venue := range dexVenueRegistry.venues {
+		if common.HexToAddress(venue.Factory) == factory {
+			return venue, true
+		}
+	}
+	return nil, false
+}
+
+// First venue registered under `name`
+func lookupDexVenueByName(name string) (*dexVenue, bool) {
+	dexVenueRegistry.once.Do(loadDexVenues)
+	for _, venue := range defaultDexVenues {
+		if venue.Name == name {
+			return venue, true
+		}
+	}
+	for _, venue := range dexVenueRegistry.venues {
+		if venue.Name == name {
+			return venue, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,156 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	. "github.com/logrusorgru/aurora"
+	"github.com/taarushv/helios/contracts/dydxBTCUSDCFundingRateOracle"
+)
+
+// dYdX perpetual funding rate oracles (P1FundingOracle)
+// The owner calls setFundingRate((uint256 value, bool isPositive)) with a per second rate (18 decimals)
+// The contract bounds the new rate against the current one before storing it, so the submitted rate isn't always what gets applied
+var p1FundingOracleAbi, _ = abi.JSON(strings.NewReader(dydxBTCUSDCFundingRateOracle.P1FundingOracleABI))
+
+// setFundingRate((uint256,bool))
+var dydxSetFundingRate = []byte{0xef, 0x46, 0x0e, 0x36}
+
+// Funding rate oracle => perpetual market
+var dydxFundingRateOracles = map[string]string{
+	"0x4525D2B71f7f018c9EBddFcD336852A85404e75B": "BTC-USDC",
+	"0x8B90515C7a99b7Edd97702c04d1E3666281De1B0": "LINK-USDC",
+}
+
+// Storage slot of _FUNDING_RATE_ (slot 0 is Ownable's _owner)
+// Packed as uint32 timestamp | bool isPositive | uint128 value, starting from the lowest order bytes
+var dydxFundingRateSlot = common.BigToHash(big.NewInt(1))
+
+// dYdX quotes funding as an 8 hour rate
+const dydxFundingPeriod = 8 * 60 * 60
+
+type dydxFundingRateUpdate struct {
+	Market               string  `json:"dydxMarket"`                 // "BTC-USDC"
+	SubmittedRate        float64 `json:"dydxSubmittedFundingRate8h"` // % per 8 hours, as passed to setFundingRate
+	CurrentRate          float64 `json:"dydxCurrentFundingRate8h"`
+	NextRate             float64 `json:"dydxNextFundingRate8h"` // Rate that gets stored once the contract bounds the submitted rate
+	RateChange           float64 `json:"dydxFundingRateChange8h"`
+	Bounded              bool    `json:"dydxFundingRateBounded"` // If the submitted rate moved too far and gets clamped
+	CurrentRateUpdatedAt int64   `json:"dydxCurrentFundingRateUpdatedAt"`
+}
+
+func isDydxFundingRateUpdate(tx *types.Transaction) bool {
+	if len(tx.Data()) < 4 || !bytes.Equal(tx.Data()[:4], dydxSetFundingRate) {
+		return false
+	}
+	_, ok := dydxFundingRateOracles[tx.To().Hex()]
+	return ok
+}
+
+// Current on-chain rate (signed, per second) and the time it was set
+func getDydxFundingRate(oracle common.Address, client *ethclient.Client) (*big.Int, int64, error) {
+	word, err := client.StorageAt(context.Background(), oracle, dydxFundingRateSlot, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(word) != 32 {
+		return nil, 0, fmt.Errorf("unexpected storage word length %d", len(word))
+	}
+	timestamp := int64(binary.BigEndian.Uint32(word[28:32]))
+	rate := new(big.Int).SetBytes(word[11:27])
+	if word[27] == 0 {
+		rate.Neg(rate)
+	}
+	return rate, timestamp, nil
+}
+
+// Same bounds as P1FundingOracle._boundRate, evaluated at `now` (our best guess for the block timestamp)
+func boundDydxFundingRate(newRate *big.Int, oldRate *big.Int, oldTimestamp int64, now int64, maxAbsValue *big.Int, maxAbsDiffPerUpdate *big.Int, maxAbsDiffPerSecond *big.Int) *big.Int {
+	timeDelta := now - oldTimestamp
+	if timeDelta < 0 {
+		timeDelta = 0
+	}
+	maxDiff := new(big.Int).Mul(maxAbsDiffPerSecond, big.NewInt(timeDelta))
+	if maxDiff.Cmp(maxAbsDiffPerUpdate) > 0 {
+		maxDiff = maxAbsDiffPerUpdate
+	}
+	if newRate.Cmp(oldRate) > 0 {
+		upperBound := new(big.Int).Add(oldRate, maxDiff)
+		if upperBound.Cmp(maxAbsValue) > 0 {
+			upperBound = maxAbsValue
+		}
+		if newRate.Cmp(upperBound) > 0 {
+			return new(big.Int).Set(upperBound)
+		}
+		return new(big.Int).Set(newRate)
+	}
+	lowerBound := new(big.Int).Sub(oldRate, maxDiff)
+	minValue := new(big.Int).Neg(maxAbsValue)
+	if lowerBound.Cmp(minValue) < 0 {
+		lowerBound = minValue
+	}
+	if newRate.Cmp(lowerBound) < 0 {
+		return new(big.Int).Set(lowerBound)
+	}
+	return new(big.Int).Set(newRate)
+}
+
+// Per second rate (18 decimals) to % per 8 hours
+func formatDydxFundingRate(rate *big.Int) float64 {
+	periodRate := new(big.Int).Mul(rate, big.NewInt(dydxFundingPeriod*100))
+	return formatChainlinkOraclePrice(periodRate, 18)
+}
+
+func handleDydxFundingRateUpdate(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+	// Single tuple argument, so it unpacks straight into the struct
+	var newRate dydxBTCUSDCFundingRateOracle.SignedMathInt
+	if err := p1FundingOracleAbi.Methods["setFundingRate"].Inputs.Unpack(&newRate, tx.Data()[4:]); err != nil {
+		fmt.Println("Error decoding dydx funding rate update:", tx.Hash().Hex(), err)
+		if fullMode {
+			handleMiscTx(tx, client, isStealth)
+		}
+		return
+	}
+	submittedRate := new(big.Int).Set(newRate.Value)
+	if !newRate.IsPositive {
+		submittedRate.Neg(submittedRate)
+	}
+	final := dydxFundingRateUpdate{
+		Market:        dydxFundingRateOracles[tx.To().Hex()],
+		SubmittedRate: formatDydxFundingRate(submittedRate),
+		NextRate:      formatDydxFundingRate(submittedRate),
+	}
+	currentRate, updatedAt, err := getDydxFundingRate(*tx.To(), client)
+	if err != nil {
+		fmt.Println("Error fetching dydx funding rate:", err)
+	} else {
+		final.CurrentRate = formatDydxFundingRate(currentRate)
+		final.CurrentRateUpdatedAt = updatedAt
+		oracleInstance, _ := dydxBTCUSDCFundingRateOracle.NewP1FundingOracle(*tx.To(), client)
+		maxAbsValue, err1 := oracleInstance.MAXABSVALUE(nil)
+		maxAbsDiffPerUpdate, err2 := oracleInstance.MAXABSDIFFPERUPDATE(nil)
+		maxAbsDiffPerSecond, err3 := oracleInstance.MAXABSDIFFPERSECOND(nil)
+		if err1 == nil && err2 == nil && err3 == nil {
+			nextRate := boundDydxFundingRate(submittedRate, currentRate, updatedAt, time.Now().Unix(), maxAbsValue, maxAbsDiffPerUpdate, maxAbsDiffPerSecond)
+			final.NextRate = formatDydxFundingRate(nextRate)
+			final.Bounded = nextRate.Cmp(submittedRate) != 0
+		}
+		final.RateChange = final.NextRate - final.CurrentRate
+	}
+	fmt.Println()
+	fmt.Println(Magenta("New TX: dYdX Funding Rate Update"))
+	fmt.Println("Hash: ", tx.Hash().Hex(), " Market: ", final.Market)
+	fmt.Println("Current Rate (8h %): ", final.CurrentRate, " Next Rate (8h %): ", final.NextRate, " Bounded: ", final.Bounded)
+	if fullMode {
+		handleDydxFundingRate(tx, client, isStealth, final)
+	}
+}
@@ -0,0 +1,318 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/taarushv/helios/contracts/chainlinkACA"
+	"github.com/taarushv/helios/contracts/chainlinkOCR"
+	"github.com/taarushv/helios/contracts/dydxBTCUSDCFundingRateOracle"
+	"github.com/taarushv/helios/contracts/erc20"
+	"github.com/taarushv/helios/contracts/maker"
+	"github.com/taarushv/helios/contracts/uniswap"
+)
+
+// Event topic database, the log counterpart of the 4byte database (fourBytes.go)
+// Loaded from ./data/event-signatures (one file per topic0, named after it without 0x, override with EVENT_SIGNATURES_PATH in .env)
+// and from the events of every ABI registered through registerEventsFromABI
+// Text signatures can mark parameters as indexed ("Transfer(address indexed from,address indexed to,uint256 value)"),
+// unmarked ones are assumed to index their first len(topics)-1 parameters
+// The same topic can have several layouts (ERC20 vs ERC721 Transfer), the one that fits the log best wins
+
+const defaultEventSignaturesPath = "./data/event-signatures"
+
+// ABIs we ship bindings for, registered on load
+var defaultEventAbis = []string{
+	erc20.Erc20ABI, uniswap.UniswapPairABI, uniswap.UniswapFactoryABI, chainlinkACA.ChainlinkACAABI, chainlinkOCR.ChainlinkOCRABI,
+	maker.MedianABI, maker.OSMABI, maker.SpotterABI, dydxBTCUSDCFundingRateOracle.P1FundingOracleABI,
+}
+
+type eventSignature struct {
+	Event abi.Event
+	// Indexed flags are known (ABI or marked text signature), otherwise they're inferred from the topic count
+	ExplicitIndexed bool
+}
+
+var eventSignatureDB = struct {
+	once   sync.Once
+	lock   sync.RWMutex
+	events map[common.Hash][]*eventSignature
+}{
+	events: make(map[common.Hash][]*eventSignature),
+}
+
+type decodedLogArg struct {
+	Name    string
+	Type    string
+	Indexed bool
+	Value   string
+}
+
+type decodedLog struct {
+	Address   common.Address
+	Name      string
+	Signature string
+	Args      []decodedLogArg
+	// Re-encoding the non-indexed args gives back the exact log data
+	Exact bool
+}
+
+// `0xContract EventName(arg=value, ...)`
+func (l *decodedLog) String() string {
+	args := make([]string, len(l.Args))
+	for i, arg := range l.Args {
+		args[i] = arg.Name + "=" + arg.Value
+	}
+	return fmt.Sprintf("%s %s(%s)", l.Address.Hex(), l.Name, strings.Join(args, ", "))
+}
+
+func getEventSignaturesPath() string {
+	if path := os.Getenv("EVENT_SIGNATURES_PATH"); path != "" {
+		return path
+	}
+	return defaultEventSignaturesPath
+}
+
+// Add a candidate for its topic, skipping exact duplicates (same types and indexed layout). Must be called with the write lock held
+func addEventSignature(signature *eventSignature) {
+	for _, existing := range eventSignatureDB.events[signature.Event.ID] {
+		if existing.Event.String() == signature.Event.String() || (!signature.ExplicitIndexed && existing.Event.Sig == signature.Event.Sig) {
+			return
+		}
+	}
+	eventSignatureDB.events[signature.Event.ID] = append(eventSignatureDB.events[signature.Event.ID], signature)
+}
+
+// "address indexed from" => address, true, "from"
+func parseEventParam(param string, index int) (abi.ArgumentMarshaling, bool, error) {
+	param = strings.TrimSpace(param)
+	typeEnd := strings.Index(param, " ")
+	if strings.HasPrefix(param, "(") {
+		// Skip over the tuple's components before looking for the name
+		depth := 0
+		for i, c := range param {
+			if c == '(' {
+				depth++
+			} else if c == ')' {
+				depth--
+				if depth == 0 {
+					typeEnd = strings.Index(param[i:], " ")
+					if typeEnd >= 0 {
+						typeEnd += i
+					}
+					break
+				}
+			}
+		}
+	}
+	typ, rest := param, ""
+	if typeEnd >= 0 {
+		typ, rest = param[:typeEnd], param[typeEnd+1:]
+	}
+	words := strings.Fields(rest)
+	indexed := len(words) > 0 && words[0] == "indexed"
+	if indexed {
+		words = words[1:]
+	}
+	name := fmt.Sprintf("arg%d", index)
+	if len(words) > 0 {
+		name = words[0]
+	}
+	marshaling, err := parseSignatureType(typ, name)
+	return marshaling, indexed, err
+}
+
+func parseEventSignature(signature string) (*eventSignature, error) {
+	start := strings.Index(signature, "(")
+	if start <= 0 || !strings.HasSuffix(signature, ")") {
+		return nil, fmt.Errorf("invalid event signature %s", signature)
+	}
+	explicit := false
+	var inputs abi.Arguments
+	for i, param := range splitSignatureTypes(signature[start+1 : len(signature)-1]) {
+		marshaling, indexed, err := parseEventParam(param, i)
+		if err != nil {
+			return nil, err
+		}
+		argType, err := abi.NewType(marshaling.Type, "", marshaling.Components)
+		if err != nil {
+			return nil, err
+		}
+		explicit = explicit || indexed
+		inputs = append(inputs, abi.Argument{Name: marshaling.Name, Type: argType, Indexed: indexed})
+	}
+	name := signature[:start]
+	return &eventSignature{Event: abi.NewEvent(name, name, false, inputs), ExplicitIndexed: explicit}, nil
+}
+
+// Register every (non anonymous) event of an ABI, safe to call at any time
+func registerEventsFromABI(abiJSON string) error {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return err
+	}
+	eventSignatureDB.lock.Lock()
+	defer eventSignatureDB.lock.Unlock()
+	for _, event := range parsed.Events {
+		if !event.Anonymous {
+			addEventSignature(&eventSignature{Event: event, ExplicitIndexed: true})
+		}
+	}
+	return nil
+}
+
+func loadEventSignatureDB() {
+	for _, abiJSON := range defaultEventAbis {
+		if err := registerEventsFromABI(abiJSON); err != nil {
+			fmt.Println("Error registering events:", err)
+		}
+	}
+	files, err := ioutil.ReadDir(getEventSignaturesPath())
+	if err != nil {
+		fmt.Println("Error loading event signatures:", err)
+		return
+	}
+	eventSignatureDB.lock.Lock()
+	defer eventSignatureDB.lock.Unlock()
+	for _, f := range files {
+		topic, err := hex.DecodeString(f.Name())
+		if err != nil || len(topic) != 32 {
+			continue
+		}
+		buf, err := ioutil.ReadFile(filepath.Join(getEventSignaturesPath(), f.Name()))
+		if err != nil {
+			continue
+		}
+		for _, line := range strings.Split(string(buf), "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" {
+				continue
+			}
+			signature, err := parseEventSignature(line)
+			if err != nil || signature.Event.ID != common.BytesToHash(topic) {
+				fmt.Println("Skipping event signature", line, "for topic", f.Name())
+				continue
+			}
+			addEventSignature(signature)
+		}
+	}
+}
+
+func lookupEventSignatures(topic common.Hash) []*eventSignature {
+	eventSignatureDB.once.Do(loadEventSignatureDB)
+	eventSignatureDB.lock.RLock()
+	defer eventSignatureDB.lock.RUnlock()
+	return eventSignatureDB.events[topic]
+}
+
+// Decode a log against one candidate, nil if it doesn't fit
+func decodeLogWithSignature(vLog *types.Log, signature *eventSignature) (decoded *decodedLog) {
+	defer func() {
+		if recover() != nil {
+			decoded = nil
+		}
+	}()
+	inputs := make(abi.Arguments, len(signature.Event.Inputs))
+	copy(inputs, signature.Event.Inputs)
+	indexedCount := 0
+	for _, input := range inputs {
+		if input.Indexed {
+			indexedCount++
+		}
+	}
+	if indexedCount != len(vLog.Topics)-1 {
+		if signature.ExplicitIndexed || len(vLog.Topics)-1 > len(inputs) {
+			return nil
+		}
+		for i := range inputs {
+			inputs[i].Indexed = i < len(vLog.Topics)-1
+		}
+	}
+	var indexed, nonIndexed abi.Arguments
+	for _, input := range inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		} else {
+			nonIndexed = append(nonIndexed, input)
+		}
+	}
+	values := make(map[string]interface{})
+	if err := abi.ParseTopicsIntoMap(values, indexed, vLog.Topics[1:]); err != nil {
+		return nil
+	}
+	dataValues, err := nonIndexed.UnpackValues(vLog.Data)
+	if err != nil {
+		return nil
+	}
+	for i, input := range nonIndexed {
+		values[input.Name] = dataValues[i]
+	}
+	decoded = &decodedLog{Address: vLog.Address, Name: signature.Event.RawName, Signature: signature.Event.Sig}
+	for _, input := range inputs {
+		decoded.Args = append(decoded.Args, decodedLogArg{Name: input.Name, Type: input.Type.String(), Indexed: input.Indexed, Value: formatDecodedValue(values[input.Name])})
+	}
+	if encoded, err := nonIndexed.PackValues(dataValues); err == nil {
+		decoded.Exact = bytes.Equal(encoded, vLog.Data)
+	}
+	return decoded
+}
+
+// Best fitting decoding of a log (exact re-encodes first, then explicit layouts), false for unknown topics and anonymous logs
+func decodeLog(vLog *types.Log) (*decodedLog, bool) {
+	if len(vLog.Topics) == 0 {
+		return nil, false
+	}
+	var best *decodedLog
+	for _, signature := range lookupEventSignatures(vLog.Topics[0]) {
+		decoded := decodeLogWithSignature(vLog, signature)
+		if decoded == nil {
+			continue
+		}
+		if best == nil || (decoded.Exact && !best.Exact) {
+			best = decoded
+		}
+	}
+	return best, best != nil
+}
+
+// Print the decoded logs of a mined tx (hash) or of every tx in a block (number)
+func PrintDecodedLogs(target string, client *ethclient.Client) {
+	if blockNo, ok := new(big.Int).SetString(target, 10); ok {
+		block, err := client.BlockByNumber(context.Background(), blockNo)
+		if err != nil {
+			log.Fatalln("Error fetching block:", err)
+		}
+		fmt.Println("Block #", block.Number(), " Txs: ", len(block.Transactions()))
+		for _, tx := range block.Transactions() {
+			printReceiptLogs(tx.Hash(), client)
+		}
+		return
+	}
+	printReceiptLogs(common.HexToHash(target), client)
+}
+
+func printReceiptLogs(txHash common.Hash, client *ethclient.Client) {
+	receipt, err := client.TransactionReceipt(context.Background(), txHash)
+	if err != nil {
+		fmt.Println("Error fetching receipt:", txHash.Hex(), err)
+		return
+	}
+	fmt.Println()
+	fmt.Println("Hash: ", txHash.Hex(), " Status: ", receipt.Status, " Logs: ", len(receipt.Logs))
+	for _, vLog := range receipt.Logs {
+		fmt.Println(decodeLocalLog(vLog))
+	}
+}
@@ -0,0 +1,228 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Native replacement for panther's ganache fork
+// A pending tx is executed on top of the latest head with go-ethereum's EVM, state is pulled in lazily (see forkStateDB.go)
+
+// Before/after values of a slot or account field the tx changed
+type StorageDiff struct {
+	From common.Hash `json:"from"`
+	To   common.Hash `json:"to"`
+}
+
+type AccountDiff struct {
+	BalanceBefore *big.Int                    `json:"balanceBefore,omitempty"`
+	BalanceAfter  *big.Int                    `json:"balanceAfter,omitempty"`
+	NonceBefore   uint64                      `json:"nonceBefore,omitempty"`
+	NonceAfter    uint64                      `json:"nonceAfter,omitempty"`
+	CodeChanged   bool                        `json:"codeChanged,omitempty"`
+	Suicided      bool                        `json:"suicided,omitempty"`
+	Storage       map[common.Hash]StorageDiff `json:"storage,omitempty"`
+}
+
+type SimulationResult struct {
+	BlockNumber  uint64                          `json:"simBlockNumber"` // Head the tx was executed on top of
+	Coinbase     common.Address                  `json:"simCoinbase"`
+	GasUsed      uint64                          `json:"simGasUsed"`
+	Failed       bool                            `json:"simFailed"`
+	RevertReason string                          `json:"simRevertReason"`
+	ReturnData   []byte                          `json:"simReturnData"`
+	Logs         []*types.Log                    `json:"simLogs"`
+	StateDiff    map[common.Address]*AccountDiff `json:"simStateDiff"`
+}
+
+// Shared cache for the current head, swapped out every time a new block is mined
+var (
+	simulatorCache     *forkCache
+	simulatorCacheLock sync.Mutex
+)
+
+// Latest head as seen by the block stream (StreamNewBlocks), so lookups against the head don't each pay for a HeaderByNumber
+var latestHead = struct {
+	lock   sync.Mutex
+	header *types.Header
+	seenAt int64
+}{}
+
+// Past this age (no block stream, or it stalled) the head is fetched from the node again (seconds)
+const latestHeadMaxAge = 30
+
+func setLatestHead(header *types.Header) {
+	latestHead.lock.Lock()
+	defer latestHead.lock.Unlock()
+	if latestHead.header == nil || header.Number.Cmp(latestHead.header.Number) >= 0 {
+		latestHead.header, latestHead.seenAt = header, time.Now().Unix()
+	}
+}
+
+func getLatestHead(client *ethclient.Client) (*types.Header, error) {
+	latestHead.lock.Lock()
+	head, seenAt := latestHead.header, latestHead.seenAt
+	latestHead.lock.Unlock()
+	if head != nil && seenAt+latestHeadMaxAge > time.Now().Unix() {
+		return head, nil
+	}
+	head, err := client.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return nil, err
+	}
+	setLatestHead(head)
+	return head, nil
+}
+
+// Return the cache for the latest head (creating a fresh one if the chain moved)
+func getSimulatorCache(client *ethclient.Client) (*forkCache, *types.Header, error) {
+	head, err := getLatestHead(client)
+	if err != nil {
+		return nil, nil, err
+	}
+	simulatorCacheLock.Lock()
+	defer simulatorCacheLock.Unlock()
+	if simulatorCache == nil || simulatorCache.blockHash != head.Hash() {
+		simulatorCache = newForkCache(head)
+	}
+	return simulatorCache, head, nil
+}
+
+// Resolves BLOCKHASH for the sim, only the last 256 blocks are reachable anyway
+func simulatorGetHashFn(client *ethclient.Client) vm.GetHashFunc {
+	return func(n uint64) common.Hash {
+		header, err := client.HeaderByNumber(context.Background(), new(big.Int).SetUint64(n))
+		if err != nil {
+			return common.Hash{}
+		}
+		return header.Hash()
+	}
+}
+
+// Execute a pending tx against the latest head as if it were the first tx in the next block
+func SimulatePendingTx(tx *types.Transaction, client *ethclient.Client) (*SimulationResult, error) {
+	cache, head, err := getSimulatorCache(client)
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := client.NetworkID(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	msg, err := tx.AsMessage(types.NewEIP155Signer(chainID))
+	if err != nil {
+		return nil, err
+	}
+	// Skip the nonce check, the sender might have other txs queued ahead of this one
+	msg = types.NewMessage(msg.From(), msg.To(), msg.Nonce(), msg.Value(), msg.Gas(), msg.GasPrice(), msg.Data(), false)
+
+	stateDB := newForkStateDB(cache, client)
+	stateDB.txHash = tx.Hash()
+	blockTime := uint64(time.Now().Unix())
+	if blockTime <= head.Time {
+		blockTime = head.Time + 1
+	}
+	blockContext := vm.Context{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		GetHash:     simulatorGetHashFn(client),
+		Origin:      msg.From(),
+		GasPrice:    new(big.Int).Set(msg.GasPrice()),
+		Coinbase:    head.Coinbase,
+		GasLimit:    head.GasLimit,
+		BlockNumber: new(big.Int).Add(head.Number, big.NewInt(1)),
+		Time:        new(big.Int).SetUint64(blockTime),
+		Difficulty:  head.Difficulty,
+	}
+	evm := vm.NewEVM(blockContext, stateDB, params.MainnetChainConfig, vm.Config{})
+	gasPool := new(core.GasPool).AddGas(math.MaxUint64)
+	execution, err := core.ApplyMessage(evm, msg, gasPool)
+	if stateDB.err != nil {
+		return nil, stateDB.err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SimulationResult{
+		BlockNumber: head.Number.Uint64(),
+		Coinbase:    head.Coinbase,
+		GasUsed:     execution.UsedGas,
+		Failed:      execution.Failed(),
+		ReturnData:  execution.Return(),
+		Logs:        stateDB.logs,
+		StateDiff:   stateDB.diff(),
+	}
+	if execution.Failed() {
+		result.RevertReason = execution.Err.Error()
+		if reason, err := abi.UnpackRevert(execution.Revert()); err == nil {
+			result.RevertReason = reason
+		}
+	}
+	return result, nil
+}
+
+// Compare every account the sim touched with what the chain had at the forked block
+func (s *forkStateDB) diff() map[common.Address]*AccountDiff {
+	diffs := make(map[common.Address]*AccountDiff)
+	for addr, obj := range s.objects {
+		original, err := s.cache.getAccount(addr, s.client)
+		if err != nil {
+			continue
+		}
+		accountDiff := &AccountDiff{Suicided: obj.suicided}
+		if original.balance.Cmp(obj.balance) != 0 {
+			accountDiff.BalanceBefore = original.balance
+			accountDiff.BalanceAfter = obj.balance
+		}
+		if original.nonce != obj.nonce {
+			accountDiff.NonceBefore = original.nonce
+			accountDiff.NonceAfter = obj.nonce
+		}
+		accountDiff.CodeChanged = string(original.code) != string(obj.code)
+		for key, value := range obj.storage {
+			committed := s.GetCommittedState(addr, key)
+			if committed != value {
+				if accountDiff.Storage == nil {
+					accountDiff.Storage = make(map[common.Hash]StorageDiff)
+				}
+				accountDiff.Storage[key] = StorageDiff{From: committed, To: value}
+			}
+		}
+		if accountDiff.BalanceAfter != nil || accountDiff.NonceBefore != accountDiff.NonceAfter || accountDiff.CodeChanged || accountDiff.Suicided || accountDiff.Storage != nil {
+			diffs[addr] = accountDiff
+		}
+	}
+	return diffs
+}
+
+// CLI helper (`./helios -simulate=0x...`), prints the sim result of a tx the node knows about
+func SimulateTxByHash(txHash string, client *ethclient.Client) {
+	tx, _, err := client.TransactionByHash(context.Background(), common.HexToHash(txHash))
+	if err != nil {
+		log.Fatal(err)
+	}
+	result, err := SimulatePendingTx(tx, client)
+	if err != nil {
+		log.Fatalln("Error simulating tx:", err)
+	}
+	fmt.Println("Simulated on top of block #", result.BlockNumber)
+	fmt.Println("Gas used: ", result.GasUsed, " Failed: ", result.Failed, " Revert reason: ", result.RevertReason)
+	fmt.Println("Logs emitted: ", len(result.Logs), " Accounts changed: ", len(result.StateDiff))
+	for _, vLog := range result.Logs {
+		fmt.Println(decodeLocalLog(vLog))
+	}
+}
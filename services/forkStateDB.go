@@ -0,0 +1,331 @@
+package services
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// Lazily loaded mainnet state for local EVM execution (replaces the ganache fork in panther)
+// Nothing is copied up front, accounts/code/storage are pulled over RPC the first time the EVM touches them
+// Everything fetched is cached against the block it was read at, so sims on the same head share the reads
+
+// Account data as it was at the forked block
+type forkAccount struct {
+	balance *big.Int
+	nonce   uint64
+	code    []byte
+}
+
+// Cache of remote reads for a single block, dropped as soon as a new head shows up
+type forkCache struct {
+	lock        sync.Mutex
+	blockNumber *big.Int
+	blockHash   common.Hash
+	accounts    map[common.Address]*forkAccount
+	storage     map[common.Address]map[common.Hash]common.Hash
+}
+
+func newForkCache(head *types.Header) *forkCache {
+	return &forkCache{
+		blockNumber: head.Number,
+		blockHash:   head.Hash(),
+		accounts:    make(map[common.Address]*forkAccount),
+		storage:     make(map[common.Address]map[common.Hash]common.Hash),
+	}
+}
+
+func (c *forkCache) getAccount(addr common.Address, client *ethclient.Client) (*forkAccount, error) {
+	c.lock.Lock()
+	account, ok := c.accounts[addr]
+	c.lock.Unlock()
+	if ok {
+		return account, nil
+	}
+	balance, err := client.BalanceAt(context.Background(), addr, c.blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := client.NonceAt(context.Background(), addr, c.blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	code, err := client.CodeAt(context.Background(), addr, c.blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	account = &forkAccount{balance: balance, nonce: nonce, code: code}
+	c.lock.Lock()
+	c.accounts[addr] = account
+	c.lock.Unlock()
+	return account, nil
+}
+
+func (c *forkCache) getStorage(addr common.Address, key common.Hash, client *ethclient.Client) (common.Hash, error) {
+	c.lock.Lock()
+	value, ok := c.storage[addr][key]
+	c.lock.Unlock()
+	if ok {
+		return value, nil
+	}
+	raw, err := client.StorageAt(context.Background(), addr, key, c.blockNumber)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	value = common.BytesToHash(raw)
+	c.lock.Lock()
+	if c.storage[addr] == nil {
+		c.storage[addr] = make(map[common.Hash]common.Hash)
+	}
+	c.storage[addr][key] = value
+	c.lock.Unlock()
+	return value, nil
+}
+
+// Local (dirty) copy of an account, only exists once the sim reads or writes it
+type forkStateObject struct {
+	balance  *big.Int
+	nonce    uint64
+	code     []byte
+	storage  map[common.Hash]common.Hash
+	suicided bool
+	created  bool
+}
+
+// forkStateDB implements vm.StateDB on top of a forkCache
+// Writes never leave this struct, so a sim can't pollute the shared cache
+type forkStateDB struct {
+	client  *ethclient.Client
+	cache   *forkCache
+	objects map[common.Address]*forkStateObject
+	refund  uint64
+	logs    []*types.Log
+	// Journal of undo functions, a snapshot is just an index into it
+	journal []func()
+	// First RPC error we ran into, the EVM interface has no way to surface it
+	err error
+	// Set by the simulator so logs are tagged properly
+	txHash common.Hash
+}
+
+func newForkStateDB(cache *forkCache, client *ethclient.Client) *forkStateDB {
+	return &forkStateDB{
+		client:  client,
+		cache:   cache,
+		objects: make(map[common.Address]*forkStateObject),
+	}
+}
+
+func (s *forkStateDB) setError(err error) {
+	if s.err == nil {
+		s.err = err
+	}
+}
+
+// Fetch (or reuse) the local copy of an account
+func (s *forkStateDB) getObject(addr common.Address) *forkStateObject {
+	if obj, ok := s.objects[addr]; ok {
+		return obj
+	}
+	account, err := s.cache.getAccount(addr, s.client)
+	if err != nil {
+		s.setError(err)
+		account = &forkAccount{balance: new(big.Int)}
+	}
+	obj := &forkStateObject{
+		balance: new(big.Int).Set(account.balance),
+		nonce:   account.nonce,
+		code:    account.code,
+		storage: make(map[common.Hash]common.Hash),
+	}
+	s.objects[addr] = obj
+	return obj
+}
+
+func (s *forkStateDB) CreateAccount(addr common.Address) {
+	prev, existed := s.objects[addr]
+	obj := &forkStateObject{
+		balance: new(big.Int),
+		storage: make(map[common.Hash]common.Hash),
+		created: true,
+	}
+	// Carry over the balance, same as geth does for pre-funded addresses
+	if existed {
+		obj.balance.Set(prev.balance)
+	} else {
+		obj.balance.Set(s.getObject(addr).balance)
+	}
+	s.objects[addr] = obj
+	s.journal = append(s.journal, func() {
+		if existed {
+			s.objects[addr] = prev
+		} else {
+			delete(s.objects, addr)
+		}
+	})
+}
+
+func (s *forkStateDB) SubBalance(addr common.Address, amount *big.Int) {
+	obj := s.getObject(addr)
+	prev := new(big.Int).Set(obj.balance)
+	obj.balance = new(big.Int).Sub(obj.balance, amount)
+	s.journal = append(s.journal, func() { obj.balance = prev })
+}
+
+func (s *forkStateDB) AddBalance(addr common.Address, amount *big.Int) {
+	obj := s.getObject(addr)
+	prev := new(big.Int).Set(obj.balance)
+	obj.balance = new(big.Int).Add(obj.balance, amount)
+	s.journal = append(s.journal, func() { obj.balance = prev })
+}
+
+func (s *forkStateDB) GetBalance(addr common.Address) *big.Int {
+	return new(big.Int).Set(s.getObject(addr).balance)
+}
+
+func (s *forkStateDB) GetNonce(addr common.Address) uint64 {
+	return s.getObject(addr).nonce
+}
+
+func (s *forkStateDB) SetNonce(addr common.Address, nonce uint64) {
+	obj := s.getObject(addr)
+	prev := obj.nonce
+	obj.nonce = nonce
+	s.journal = append(s.journal, func() { obj.nonce = prev })
+}
+
+func (s *forkStateDB) GetCodeHash(addr common.Address) common.Hash {
+	if s.Empty(addr) {
+		return common.Hash{}
+	}
+	return crypto.Keccak256Hash(s.getObject(addr).code)
+}
+
+func (s *forkStateDB) GetCode(addr common.Address) []byte {
+	return s.getObject(addr).code
+}
+
+func (s *forkStateDB) SetCode(addr common.Address, code []byte) {
+	obj := s.getObject(addr)
+	prev := obj.code
+	obj.code = code
+	s.journal = append(s.journal, func() { obj.code = prev })
+}
+
+func (s *forkStateDB) GetCodeSize(addr common.Address) int {
+	return len(s.getObject(addr).code)
+}
+
+func (s *forkStateDB) AddRefund(gas uint64) {
+	prev := s.refund
+	s.refund += gas
+	s.journal = append(s.journal, func() { s.refund = prev })
+}
+
+func (s *forkStateDB) SubRefund(gas uint64) {
+	prev := s.refund
+	if gas > s.refund {
+		s.refund = 0
+	} else {
+		s.refund -= gas
+	}
+	s.journal = append(s.journal, func() { s.refund = prev })
+}
+
+func (s *forkStateDB) GetRefund() uint64 {
+	return s.refund
+}
+
+// Value at the start of the sim (what the chain has at the forked block)
+func (s *forkStateDB) GetCommittedState(addr common.Address, key common.Hash) common.Hash {
+	if obj := s.getObject(addr); obj.created {
+		return common.Hash{}
+	}
+	value, err := s.cache.getStorage(addr, key, s.client)
+	if err != nil {
+		s.setError(err)
+	}
+	return value
+}
+
+func (s *forkStateDB) GetState(addr common.Address, key common.Hash) common.Hash {
+	if value, ok := s.getObject(addr).storage[key]; ok {
+		return value
+	}
+	return s.GetCommittedState(addr, key)
+}
+
+func (s *forkStateDB) SetState(addr common.Address, key common.Hash, value common.Hash) {
+	obj := s.getObject(addr)
+	prev, dirty := obj.storage[key]
+	obj.storage[key] = value
+	s.journal = append(s.journal, func() {
+		if dirty {
+			obj.storage[key] = prev
+		} else {
+			delete(obj.storage, key)
+		}
+	})
+}
+
+func (s *forkStateDB) Suicide(addr common.Address) bool {
+	obj := s.getObject(addr)
+	prevSuicided, prevBalance := obj.suicided, obj.balance
+	obj.suicided = true
+	obj.balance = new(big.Int)
+	s.journal = append(s.journal, func() {
+		obj.suicided = prevSuicided
+		obj.balance = prevBalance
+	})
+	return true
+}
+
+func (s *forkStateDB) HasSuicided(addr common.Address) bool {
+	return s.getObject(addr).suicided
+}
+
+func (s *forkStateDB) Exist(addr common.Address) bool {
+	obj := s.getObject(addr)
+	return obj.created || obj.suicided || !s.Empty(addr)
+}
+
+func (s *forkStateDB) Empty(addr common.Address) bool {
+	obj := s.getObject(addr)
+	return obj.nonce == 0 && obj.balance.Sign() == 0 && len(obj.code) == 0
+}
+
+func (s *forkStateDB) RevertToSnapshot(id int) {
+	for i := len(s.journal) - 1; i >= id; i-- {
+		s.journal[i]()
+	}
+	s.journal = s.journal[:id]
+}
+
+func (s *forkStateDB) Snapshot() int {
+	return len(s.journal)
+}
+
+func (s *forkStateDB) AddLog(log *types.Log) {
+	log.TxHash = s.txHash
+	log.BlockNumber = s.cache.blockNumber.Uint64() + 1
+	log.Index = uint(len(s.logs))
+	s.logs = append(s.logs, log)
+	s.journal = append(s.journal, func() { s.logs = s.logs[:len(s.logs)-1] })
+}
+
+func (s *forkStateDB) AddPreimage(common.Hash, []byte) {}
+
+// Only the slots we've written locally, we can't enumerate remote storage over RPC
+func (s *forkStateDB) ForEachStorage(addr common.Address, cb func(common.Hash, common.Hash) bool) error {
+	for key, value := range s.getObject(addr).storage {
+		if !cb(key, value) {
+			return nil
+		}
+	}
+	return nil
+}
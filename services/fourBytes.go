@@ -0,0 +1,232 @@
+package services
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Offline 4byte database, loaded from ./data/fn-signatures (one file per selector, named after it, override with FN_SIGNATURES_PATH in .env)
+// Used to put a method name + arguments on txs none of the classifiers know about
+// A file can hold more than one signature (one per line or `;` separated) when selectors collide, candidates are ranked by how cleanly the calldata decodes against them
+
+const defaultFnSignaturesPath = "./data/fn-signatures"
+
+var fourBytesDB = struct {
+	once       sync.Once
+	signatures map[[4]byte][]string
+}{}
+
+// Calldata decoded against one signature
+type decodedCall struct {
+	Method    string
+	Signature string
+	Args      []string
+	// Re-encoding the args gives back the exact calldata, as opposed to just decoding without errors
+	Exact bool
+}
+
+func getFnSignaturesPath() string {
+	if path := os.Getenv("FN_SIGNATURES_PATH"); path != "" {
+		return path
+	}
+	return defaultFnSignaturesPath
+}
+
+func loadFourBytesDB() {
+	signatures := make(map[[4]byte][]string)
+	files, err := ioutil.ReadDir(getFnSignaturesPath())
+	if err != nil {
+		fmt.Println("Error loading fn signatures:", err)
+		fourBytesDB.signatures = signatures
+		return
+	}
+	for _, f := range files {
+		selector, err := hex.DecodeString(f.Name())
+		if err != nil || len(selector) != 4 {
+			continue
+		}
+		buf, err := ioutil.ReadFile(filepath.Join(getFnSignaturesPath(), f.Name()))
+		if err != nil {
+			continue
+		}
+		var key [4]byte
+		copy(key[:], selector)
+		// Colliding signatures come one per line or `;` separated on a single line
+		for _, line := range strings.FieldsFunc(string(buf), func(r rune) bool { return r == '\n' || r == ';' }) {
+			if signature := strings.TrimSpace(line); signature != "" {
+				signatures[key] = append(signatures[key], signature)
+			}
+		}
+	}
+	fourBytesDB.signatures = signatures
+	fmt.Println("Loaded", len(signatures), "fn signatures")
+}
+
+// Loading takes a few seconds, so it's kicked off in the background when streaming starts
+func preloadFourBytesDB() {
+	fourBytesDB.once.Do(loadFourBytesDB)
+}
+
+func lookupFnSignatures(selector []byte) []string {
+	fourBytesDB.once.Do(loadFourBytesDB)
+	var key [4]byte
+	copy(key[:], selector)
+	return fourBytesDB.signatures[key]
+}
+
+// Split on commas that aren't inside a tuple
+func splitSignatureTypes(types string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, c := range types {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, types[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if start < len(types) {
+		parts = append(parts, types[start:])
+	}
+	return parts
+}
+
+// "(address,uint256)[]" => tuple[] with two components, anything else is passed through as is
+func parseSignatureType(typ string, name string) (abi.ArgumentMarshaling, error) {
+	if !strings.HasPrefix(typ, "(") {
+		return abi.ArgumentMarshaling{Name: name, Type: typ}, nil
+	}
+	end := strings.LastIndex(typ, ")")
+	if end < 0 {
+		return abi.ArgumentMarshaling{}, fmt.Errorf("unbalanced tuple %s", typ)
+	}
+	marshaling := abi.ArgumentMarshaling{Name: name, Type: "tuple" + typ[end+1:]}
+	for i, component := range splitSignatureTypes(typ[1:end]) {
+		parsed, err := parseSignatureType(component, fmt.Sprintf("field%d", i))
+		if err != nil {
+			return abi.ArgumentMarshaling{}, err
+		}
+		marshaling.Components = append(marshaling.Components, parsed)
+	}
+	return marshaling, nil
+}
+
+// "transfer(address,uint256)" => "transfer", [address, uint256]
+func parseFnSignature(signature string) (string, abi.Arguments, error) {
+	start := strings.Index(signature, "(")
+	if start <= 0 || !strings.HasSuffix(signature, ")") {
+		return "", nil, fmt.Errorf("invalid signature %s", signature)
+	}
+	var args abi.Arguments
+	for i, typ := range splitSignatureTypes(signature[start+1 : len(signature)-1]) {
+		marshaling, err := parseSignatureType(typ, fmt.Sprintf("arg%d", i))
+		if err != nil {
+			return "", nil, err
+		}
+		argType, err := abi.NewType(marshaling.Type, "", marshaling.Components)
+		if err != nil {
+			return "", nil, err
+		}
+		args = append(args, abi.Argument{Name: marshaling.Name, Type: argType})
+	}
+	return signature[:start], args, nil
+}
+
+// Human readable form of a decoded value, everything ends up as a string so the ES mapping doesn't depend on the method
+func formatDecodedValue(value interface{}) string {
+	switch v := value.(type) {
+	case common.Address:
+		return v.Hex()
+	case []byte:
+		return hexutil.Encode(v)
+	case fmt.Stringer:
+		return v.String()
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Array:
+		// Fixed size bytes (bytes32 etc)
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			fixed := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(fixed), rv)
+			return hexutil.Encode(fixed)
+		}
+		fallthrough
+	case reflect.Slice:
+		items := make([]string, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			items[i] = formatDecodedValue(rv.Index(i).Interface())
+		}
+		return "[" + strings.Join(items, ",") + "]"
+	case reflect.Struct:
+		fields := make([]string, rv.NumField())
+		for i := 0; i < rv.NumField(); i++ {
+			fields[i] = formatDecodedValue(rv.Field(i).Interface())
+		}
+		return "(" + strings.Join(fields, ",") + ")"
+	}
+	return fmt.Sprint(value)
+}
+
+// Decode the calldata against `signature`, nil if it doesn't fit
+func decodeCallWithSignature(data []byte, signature string) (call *decodedCall) {
+	// Random calldata against the wrong signature can trip the decoder up in creative ways, treat any panic as "doesn't fit"
+	defer func() {
+		if recover() != nil {
+			call = nil
+		}
+	}()
+	name, args, err := parseFnSignature(signature)
+	if err != nil {
+		return nil
+	}
+	values, err := args.UnpackValues(data[4:])
+	if err != nil {
+		return nil
+	}
+	call = &decodedCall{Method: name, Signature: signature, Args: make([]string, len(values))}
+	for i, value := range values {
+		call.Args[i] = formatDecodedValue(value)
+	}
+	if encoded, err := args.PackValues(values); err == nil {
+		call.Exact = bytes.Equal(encoded, data[4:])
+	}
+	return call
+}
+
+// Every known signature the calldata decodes against, best fit first (exact re-encodes, then the rest in file order)
+func decodeCallData(data []byte) []*decodedCall {
+	if len(data) < 4 {
+		return nil
+	}
+	var exact, loose []*decodedCall
+	for _, signature := range lookupFnSignatures(data[:4]) {
+		call := decodeCallWithSignature(data, signature)
+		if call == nil {
+			continue
+		}
+		if call.Exact {
+			exact = append(exact, call)
+		} else {
+			loose = append(loose, call)
+		}
+	}
+	return append(exact, loose...)
+}
@@ -14,11 +14,8 @@ import (
 )
 
 func goDotEnvVariable(key string) string {
-	// load .env file
-	err := godotenv.Load(".env")
-	if err != nil {
-		log.Fatalf("Error loading .env file")
-	}
+	// load .env file, the variables can also come from the environment (go test runs without one)
+	godotenv.Load(".env")
 	return os.Getenv(key)
 }
 
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// Shared documents for lending protocols (compoundClassifier.go, aaveClassifier.go)
+// Pending liquidations get their own "liquidation" type so they can be queried (and backrun) without digging through regular lending actions
+
+const (
+	lendingActionMint      = "mint"
+	lendingActionRedeem    = "redeem"
+	lendingActionDeposit   = "deposit"
+	lendingActionBorrow    = "borrow"
+	lendingActionRepay     = "repay"
+	lendingActionFlashLoan = "flashLoan"
+	// Compound Comptroller
+	lendingActionEnterMarkets = "enterMarkets"
+	lendingActionExitMarket   = "exitMarket"
+	lendingActionClaimComp    = "claimComp"
+)
+
+// Both protocols take uint256(-1) as "everything" (full repay/withdraw)
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+type lendingAction struct {
+	Protocol     string    `json:"protocol"` // "Compound", "Aave V2"
+	Action       string    `json:"action"`   // "mint", "redeem", "deposit", "borrow", "repay", "flashLoan" or one of the Comptroller actions
+	Market       string    `json:"market"`   // cToken or lending pool
+	Assets       []string  `json:"assets"`
+	AssetSymbols []string  `json:"assetSymbols"`
+	Amounts      []float64 `json:"amounts"`
+	FullAmount   bool      `json:"fullAmount"` // Amount was uint256(-1), the whole debt/balance
+	Borrower     string    `json:"borrower"`   // Account whose position changes
+	// Aave only
+	InterestRateMode  string `json:"interestRateMode,omitempty"` // "stable" or "variable", "none" for flash loans that are paid back
+	FlashLoanReceiver string `json:"flashLoanReceiver,omitempty"`
+}
+
+type lendingLiquidation struct {
+	Protocol              string  `json:"protocol"`
+	Liquidator            string  `json:"liquidator"`
+	Borrower              string  `json:"borrower"`
+	DebtAsset             string  `json:"debtAsset"`
+	DebtAssetSymbol       string  `json:"debtAssetSymbol"`
+	RepayAmount           float64 `json:"repayAmount"`
+	FullAmount            bool    `json:"fullAmount"` // Aave: repay as much as the close factor allows
+	CollateralAsset       string  `json:"collateralAsset"`
+	CollateralAssetSymbol string  `json:"collateralAssetSymbol"`
+	// Compound only, cTokens the debt and collateral are held in
+	DebtMarket       string `json:"debtMarket,omitempty"`
+	CollateralMarket string `json:"collateralMarket,omitempty"`
+	// Aave only, seize aTokens instead of the underlying
+	ReceiveAToken bool `json:"receiveAToken"`
+}
+
+// Format an amount of `asset`, leaving uint256(-1) at 0 (callers flag it with FullAmount instead)
+func formatLendingAmount(amount *big.Int, asset common.Address, client *ethclient.Client) float64 {
+	if amount.Cmp(maxUint256) == 0 {
+		return 0
+	}
+	return formatAggregatorAmount(amount, asset, client)
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// Optional stage that runs after a tx has been classified (full mode only)
+// The tx is executed on top of the latest head (see evmSimulator.go) to fill `localLogs` and `tags` on the document
+// This gives us post execution insight before the tx is mined, even for obfuscated bot calls
+// Off unless helios.go turns it on (-localExec)
+var localExecution = false
+
+func SetLocalExecution(enabled bool) {
+	localExecution = enabled
+}
+
+// Tags derived from the local execution
+const (
+	tagWillRevert    = "willRevert"
+	tagEmitsSwap     = "emitsSwap"
+	tagEmitsTransfer = "emitsTransfer"
+	tagTouchesOracle = "touchesOracle"
+	tagTransfersETH  = "transfersETH"
+)
+
+// Render a log as `0xContract EventName(arg=value, ...)` through the event signature database (eventSignatures.go), falls back to raw topics for unknown events
+func decodeLocalLog(vLog *types.Log) string {
+	if len(vLog.Topics) == 0 {
+		return fmt.Sprintf("%s anonymous(data=0x%x)", vLog.Address.Hex(), vLog.Data)
+	}
+	decoded, ok := decodeLog(vLog)
+	if !ok {
+		return fmt.Sprintf("%s %s(data=0x%x)", vLog.Address.Hex(), vLog.Topics[0].Hex(), vLog.Data)
+	}
+	return decoded.String()
+}
+
+// Check if an address is one of the chainlink aggregators in the feed registry
+func isKnownPriceFeed(address common.Address) bool {
+	_, ok := lookupChainlinkFeed(address)
+	return ok
+}
+
+// Derive the post execution tags from the sim result
+func getLocalExecutionTags(tx *types.Transaction, client *ethclient.Client, result *SimulationResult) []string {
+	tagSet := make(map[string]bool)
+	if result.Failed {
+		tagSet[tagWillRevert] = true
+	}
+	for _, vLog := range result.Logs {
+		if isKnownPriceFeed(vLog.Address) {
+			tagSet[tagTouchesOracle] = true
+		}
+		if event, ok := decodeLog(vLog); ok {
+			switch event.Name {
+			case "Swap":
+				tagSet[tagEmitsSwap] = true
+			case "Transfer":
+				tagSet[tagEmitsTransfer] = true
+			case "AnswerUpdated", "NewRound", "SubmissionReceived":
+				tagSet[tagTouchesOracle] = true
+			}
+		}
+	}
+	// ETH moved to anyone other than the sender (refund) and the miner (fees), covers internal transfers too
+	sender := common.HexToAddress(getTxSenderAddress(tx, client))
+	for address, diff := range result.StateDiff {
+		if isKnownPriceFeed(address) {
+			tagSet[tagTouchesOracle] = true
+		}
+		if address == sender || address == result.Coinbase || diff.BalanceAfter == nil {
+			continue
+		}
+		if diff.BalanceAfter.Cmp(diff.BalanceBefore) > 0 {
+			tagSet[tagTransfersETH] = true
+		}
+	}
+	tags := make([]string, 0, len(tagSet))
+	for tag := range tagSet {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// Populates the `localLogs` and `tags` document fields (logs are empty unless -localExec is set, tags always carry the bot cluster tags)
+// Stealth txs are already mined, so there's nothing to learn by executing them again
+func getLocalLogsAndTags(tx *types.Transaction, client *ethclient.Client, isStealth bool) ([]string, []string) {
+	localLogs, tags := getLocalExecution(tx, client, isStealth)
+	tags = append(tags, getBotClusterTags(tx, client)...)
+	return localLogs, tags
+}
+
+func getLocalExecution(tx *types.Transaction, client *ethclient.Client, isStealth bool) ([]string, []string) {
+	if !localExecution || isStealth {
+		return []string{}, []string{}
+	}
+	result, err := SimulatePendingTx(tx, client)
+	if err != nil {
+		fmt.Println("Error executing tx locally:", tx.Hash().Hex(), err)
+		return []string{}, []string{}
+	}
+	discoverChainlinkFeedsFromLogs(result.Logs, client)
+	localLogs := make([]string, len(result.Logs))
+	for i, vLog := range result.Logs {
+		localLogs[i] = decodeLocalLog(vLog)
+	}
+	return localLogs, getLocalExecutionTags(tx, client, result)
+}
@@ -0,0 +1,52 @@
+package services
+
+import (
+	"container/list"
+	"sync"
+)
+
+// Fixed size least recently used cache, for lookups keyed by tx or address that would otherwise grow for as long as the stream runs
+
+type lruCache struct {
+	lock    sync.Mutex
+	size    int
+	entries map[interface{}]*list.Element
+	order   *list.List // Front is the most recently used
+}
+
+type lruEntry struct {
+	key   interface{}
+	value interface{}
+}
+
+func newLRUCache(size int) *lruCache {
+	return &lruCache{size: size, entries: make(map[interface{}]*list.Element), order: list.New()}
+}
+
+func (cache *lruCache) get(key interface{}) (interface{}, bool) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	element, ok := cache.entries[key]
+	if !ok {
+		return nil, false
+	}
+	cache.order.MoveToFront(element)
+	return element.Value.(*lruEntry).value, true
+}
+
+// Add or replace an entry, the least recently used one is evicted once the cache is full
+func (cache *lruCache) add(key interface{}, value interface{}) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	if element, ok := cache.entries[key]; ok {
+		element.Value.(*lruEntry).value = value
+		cache.order.MoveToFront(element)
+		return
+	}
+	cache.entries[key] = cache.order.PushFront(&lruEntry{key: key, value: value})
+	if cache.order.Len() > cache.size {
+		oldest := cache.order.Back()
+		cache.order.Remove(oldest)
+		delete(cache.entries, oldest.Value.(*lruEntry).key)
+	}
+}
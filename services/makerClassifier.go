@@ -0,0 +1,320 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	. "github.com/logrusorgru/aurora"
+	"github.com/taarushv/helios/contracts/maker"
+)
+
+// Maker oracles, two layers:
+// Median: relayers `poke` signed prices from the feeds, the median of those becomes the new value right away
+// OSM (oracle security module): anyone can `poke` once per `hop` (1h), it releases the queued price and queues the median's current value
+// Vaults are valued (and liquidated) off the OSM's released price, once the Spotter is poked, so a poke tells us the next price an hour early
+var makerMedianAbi, _ = abi.JSON(strings.NewReader(maker.MedianABI))
+
+// poke() on OSMs
+var makerOSMPoke = []byte{0x18, 0x17, 0x83, 0x58}
+
+// poke(uint256[],uint256[],uint8[],bytes32[],bytes32[]) on medians
+var makerMedianPoke = []byte{0x89, 0xbb, 0xb8, 0xb2}
+
+// Spotter holds the pip (price feed) of every collateral type
+var makerSpotterAddress = common.HexToAddress("0x65C79fcB50Ca1594B025960e539eD7A9a6D434A3")
+
+// Collateral types we look up on the Spotter, stablecoin ilks use fixed price pips and are skipped automatically
+var makerIlks = []string{"ETH-A", "ETH-B", "BAT-A", "WBTC-A", "KNC-A", "ZRX-A", "MANA-A", "USDT-A", "COMP-A", "LRC-A", "LINK-A", "BAL-A", "YFI-A", "UNI-A", "RENBTC-A", "AAVE-A"}
+
+// How long the ilk => oracle mapping is trusted before we rebuild it from the Spotter
+const makerOraclesRefreshInterval = 60 * 60
+
+// OSM storage (the values we need aren't readable without being whitelisted, `peek` and `peep` are tolled)
+// slot 2: address src | uint16 hop | uint64 zzz, slot 3: Feed cur, slot 4: Feed nxt (Feed is uint128 val | uint128 has)
+var (
+	makerOSMSrcSlot = common.BigToHash(big.NewInt(2))
+	makerOSMCurSlot = common.BigToHash(big.NewInt(3))
+	makerOSMNxtSlot = common.BigToHash(big.NewInt(4))
+)
+
+// Median storage, slot 1: uint128 val | uint32 age
+var makerMedianValSlot = common.BigToHash(big.NewInt(1))
+
+const (
+	makerOracleTypeOSM    = "osm"
+	makerOracleTypeMedian = "median"
+)
+
+type makerOracle struct {
+	Address common.Address
+	Type    string
+	Ilks    []string
+	// Medians only, OSMs reading from this median
+	OSMs []common.Address
+}
+
+var makerOracleRegistry = struct {
+	lock      sync.Mutex
+	oracles   map[common.Address]*makerOracle
+	updatedAt int64
+}{
+	oracles: make(map[common.Address]*makerOracle),
+}
+
+type makerOSMState struct {
+	Src common.Address
+	Hop uint64
+	Zzz uint64   // Time of the last poke (rounded down to a multiple of hop)
+	Cur *big.Int // Price vaults are currently valued at
+	Nxt *big.Int // Queued price, released on the next poke
+}
+
+type makerOracleUpdate struct {
+	OracleType             string   `json:"makerOracleType"` // "osm" or "median"
+	Ilks                   []string `json:"makerIlks"`       // Collateral types priced by this oracle ("ETH-A", "ETH-B" ...)
+	CurrentPrice           float64  `json:"makerCurrentPrice"`
+	NextPrice              float64  `json:"makerNextPrice"`   // OSM: price released by this poke, median: new median
+	QueuedPrice            float64  `json:"makerQueuedPrice"` // Price that will be released on the following OSM poke
+	PriceChange            float64  `json:"makerPriceChangePercent"`
+	Passed                 bool     `json:"makerOSMPassed"` // OSM only, false if hop hasn't elapsed and the poke reverts
+	QueuedPriceEffectiveAt int64    `json:"makerQueuedPriceEffectiveAt"`
+	SecondsUntilEffective  int64    `json:"makerSecondsUntilQueuedPriceEffective"`
+}
+
+func stringToBytes32(value string) [32]byte {
+	var result [32]byte
+	copy(result[:], value)
+	return result
+}
+
+// Rebuild the oracle mapping from the Spotter, must be called with the registry lock held
+func refreshMakerOracles(client *ethclient.Client) {
+	spotterInstance, err := maker.NewSpotter(makerSpotterAddress, client)
+	if err != nil {
+		return
+	}
+	oracles := make(map[common.Address]*makerOracle)
+	for _, ilk := range makerIlks {
+		info, err := spotterInstance.Ilks(nil, stringToBytes32(ilk))
+		if err != nil || info.Pip == (common.Address{}) {
+			continue
+		}
+		// Only OSMs have a src, fixed price pips (DSValue) don't
+		OSMInstance, _ := maker.NewOSM(info.Pip, client)
+		src, err := OSMInstance.Src(nil)
+		if err != nil || src == (common.Address{}) {
+			continue
+		}
+		osm, ok := oracles[info.Pip]
+		if !ok {
+			osm = &makerOracle{Address: info.Pip, Type: makerOracleTypeOSM}
+			oracles[info.Pip] = osm
+		}
+		osm.Ilks = append(osm.Ilks, ilk)
+		median, ok := oracles[src]
+		if !ok {
+			median = &makerOracle{Address: src, Type: makerOracleTypeMedian}
+			oracles[src] = median
+		}
+		median.Ilks = append(median.Ilks, ilk)
+		if !containsAddress(median.OSMs, info.Pip) {
+			median.OSMs = append(median.OSMs, info.Pip)
+		}
+	}
+	if len(oracles) == 0 {
+		fmt.Println("Error refreshing maker oracles, keeping the previous mapping")
+		return
+	}
+	for _, oracle := range oracles {
+		sort.Strings(oracle.Ilks)
+	}
+	makerOracleRegistry.oracles = oracles
+	makerOracleRegistry.updatedAt = time.Now().Unix()
+}
+
+func containsAddress(addresses []common.Address, address common.Address) bool {
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
+
+func lookupMakerOracle(address common.Address, client *ethclient.Client) (*makerOracle, bool) {
+	makerOracleRegistry.lock.Lock()
+	defer makerOracleRegistry.lock.Unlock()
+	if makerOracleRegistry.updatedAt+makerOraclesRefreshInterval < time.Now().Unix() {
+		refreshMakerOracles(client)
+	}
+	oracle, ok := makerOracleRegistry.oracles[address]
+	return oracle, ok
+}
+
+// `poke` is a common name, so it only counts when it hits an OSM/median the Spotter points at
+func isMakerOracleUpdate(tx *types.Transaction, client *ethclient.Client) (*makerOracle, bool) {
+	if len(tx.Data()) < 4 {
+		return nil, false
+	}
+	isOSMPoke := bytes.Equal(tx.Data()[:4], makerOSMPoke)
+	isMedianPoke := bytes.Equal(tx.Data()[:4], makerMedianPoke)
+	if !isOSMPoke && !isMedianPoke {
+		return nil, false
+	}
+	oracle, ok := lookupMakerOracle(*tx.To(), client)
+	if !ok {
+		return nil, false
+	}
+	if (isOSMPoke && oracle.Type != makerOracleTypeOSM) || (isMedianPoke && oracle.Type != makerOracleTypeMedian) {
+		return nil, false
+	}
+	return oracle, true
+}
+
+func getMakerOSMState(osm common.Address, client *ethclient.Client) (*makerOSMState, error) {
+	srcWord, err := client.StorageAt(context.Background(), osm, makerOSMSrcSlot, nil)
+	if err != nil {
+		return nil, err
+	}
+	curWord, err := client.StorageAt(context.Background(), osm, makerOSMCurSlot, nil)
+	if err != nil {
+		return nil, err
+	}
+	nxtWord, err := client.StorageAt(context.Background(), osm, makerOSMNxtSlot, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(srcWord) != 32 || len(curWord) != 32 || len(nxtWord) != 32 {
+		return nil, fmt.Errorf("unexpected OSM storage layout at %s", osm.Hex())
+	}
+	state := &makerOSMState{
+		Src: common.BytesToAddress(srcWord[12:32]),
+		Hop: uint64(binary.BigEndian.Uint16(srcWord[10:12])),
+		Zzz: binary.BigEndian.Uint64(srcWord[2:10]),
+		Cur: new(big.Int).SetBytes(curWord[16:32]),
+		Nxt: new(big.Int).SetBytes(nxtWord[16:32]),
+	}
+	if state.Hop == 0 {
+		return nil, fmt.Errorf("unexpected OSM storage layout at %s", osm.Hex())
+	}
+	return state, nil
+}
+
+func getMakerMedianValue(median common.Address, client *ethclient.Client) (*big.Int, error) {
+	word, err := client.StorageAt(context.Background(), median, makerMedianValSlot, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(word) != 32 {
+		return nil, fmt.Errorf("unexpected median storage layout at %s", median.Hex())
+	}
+	return new(big.Int).SetBytes(word[16:32]), nil
+}
+
+// Medians sort the signed prices, the one in the middle becomes the new value (same as the contract, val_[len >> 1])
+func decodeMakerMedianPoke(data []byte) (*big.Int, error) {
+	values, err := makerMedianAbi.Methods["poke"].Inputs.UnpackValues(data[4:])
+	if err != nil {
+		return nil, err
+	}
+	prices := values[0].([]*big.Int)
+	if len(prices) == 0 {
+		return nil, fmt.Errorf("median poke without prices")
+	}
+	return prices[len(prices)>>1], nil
+}
+
+func formatMakerPriceChange(current *big.Int, next *big.Int) float64 {
+	if current.Sign() == 0 {
+		return 0
+	}
+	change := new(big.Float).SetInt(new(big.Int).Sub(next, current))
+	change.Quo(change, new(big.Float).SetInt(current))
+	final, _ := change.Mul(change, big.NewFloat(100)).Float64()
+	return final
+}
+
+func handleMakerOraclePoke(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, oracle *makerOracle) {
+	now := time.Now().Unix()
+	final := makerOracleUpdate{
+		OracleType: oracle.Type,
+		Ilks:       oracle.Ilks,
+	}
+	if oracle.Type == makerOracleTypeOSM {
+		state, err := getMakerOSMState(oracle.Address, client)
+		if err != nil {
+			fmt.Println("Error fetching maker OSM state:", err)
+			return
+		}
+		// The median's current value is what gets queued
+		queued, err := getMakerMedianValue(state.Src, client)
+		if err != nil {
+			fmt.Println("Error fetching maker median value:", err)
+			return
+		}
+		final.CurrentPrice = formatChainlinkOraclePrice(state.Cur, 18)
+		final.Passed = uint64(now) >= state.Zzz+state.Hop
+		if final.Passed {
+			zzz := uint64(now) - uint64(now)%state.Hop
+			final.NextPrice = formatChainlinkOraclePrice(state.Nxt, 18)
+			final.QueuedPrice = formatChainlinkOraclePrice(queued, 18)
+			final.PriceChange = formatMakerPriceChange(state.Cur, state.Nxt)
+			final.QueuedPriceEffectiveAt = int64(zzz + state.Hop)
+		} else {
+			// Reverts with "OSM/not-passed", nothing moves
+			final.NextPrice = final.CurrentPrice
+			final.QueuedPrice = formatChainlinkOraclePrice(state.Nxt, 18)
+			final.QueuedPriceEffectiveAt = int64(state.Zzz + state.Hop)
+		}
+	} else {
+		nextPrice, err := decodeMakerMedianPoke(tx.Data())
+		if err != nil {
+			fmt.Println("Error decoding maker median poke:", tx.Hash().Hex(), err)
+			if fullMode {
+				handleMiscTx(tx, client, isStealth)
+			}
+			return
+		}
+		currentPrice, err := getMakerMedianValue(oracle.Address, client)
+		if err != nil {
+			fmt.Println("Error fetching maker median value:", err)
+			return
+		}
+		final.CurrentPrice = formatChainlinkOraclePrice(currentPrice, 18)
+		final.NextPrice = formatChainlinkOraclePrice(nextPrice, 18)
+		final.QueuedPrice = final.NextPrice
+		final.PriceChange = formatMakerPriceChange(currentPrice, nextPrice)
+		// Gets queued by the next OSM poke (as soon as hop has passed) and released one hop after that
+		if len(oracle.OSMs) > 0 {
+			state, err := getMakerOSMState(oracle.OSMs[0], client)
+			if err == nil {
+				queuedAt := state.Zzz + state.Hop
+				if rounded := uint64(now) - uint64(now)%state.Hop; rounded > queuedAt {
+					queuedAt = rounded
+				}
+				final.QueuedPriceEffectiveAt = int64(queuedAt + state.Hop)
+			}
+		}
+	}
+	if final.QueuedPriceEffectiveAt > now {
+		final.SecondsUntilEffective = final.QueuedPriceEffectiveAt - now
+	}
+	fmt.Println()
+	fmt.Println(Cyan("New TX: Maker Oracle Update (" + final.OracleType + ")"))
+	fmt.Println("Hash: ", tx.Hash().Hex(), " Ilks: ", strings.Join(final.Ilks, ","))
+	fmt.Println("Current Price: ", final.CurrentPrice, " Next Price: ", final.NextPrice, " Queued Price: ", final.QueuedPrice, " Effective In (s): ", final.SecondsUntilEffective)
+	if fullMode {
+		handleMakerOracleUpdate(tx, client, isStealth, final)
+	}
+}
@@ -0,0 +1,312 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	. "github.com/logrusorgru/aurora"
+)
+
+// Recursive decoder for calls that arrive wrapped (Safe execTransaction/multiSend, multicall, Multicall aggregate, DSProxy and executor forwards)
+// Inner calls are run through the same filter chain as top level txs (txFilters in txClassifier.go) and listed as `innerCalls` on the outer tx's document
+// Only the calldata is unwrapped, nothing is executed, so calls a wrapper makes on its own aren't visible here (use -localExec for those)
+// How many levels of wrapped calls to unwrap, set from helios.go (-nestedDepth), 0 disables it
+var nestedCallDepth = 3
+
+func SetNestedCallDepth(depth int) {
+	nestedCallDepth = depth
+}
+
+// Printing, the document, rules and the watchlist all want a tx's inner calls, and decoding runs the matcher chain on every one of them
+// Keep the last few txs around so that only happens once per tx
+const innerCallsCacheSize = 256
+
+var innerCallsCache = newLRUCache(innerCallsCacheSize)
+
+type wrappedCall struct {
+	To           common.Address
+	Value        *big.Int
+	Data         []byte
+	DelegateCall bool
+}
+
+type callWrapper struct {
+	Name      string
+	Signature string
+	// `to` is the wrapper itself, multicalls without a target call back into it
+	unwrap   func(to common.Address, values []interface{}) []wrappedCall
+	selector []byte
+	args     abi.Arguments
+}
+
+// One unwrapped call, the tree is flattened with `path` giving its position ("1.0" = first call inside the second call)
+type innerCall struct {
+	Path            string   `json:"path"`
+	Depth           int      `json:"depth"`
+	Wrapper         string   `json:"wrapper"`      // Wrapper the call was unwrapped from
+	DelegateCall    bool     `json:"delegateCall"` // Runs with the wrapper's storage and balance (DSProxy, Safe operation 1)
+	To              string   `json:"to"`
+	Value           float64  `json:"value"`
+	Classifier      string   `json:"classifier"` // Filter that would've picked the call up as a top level tx, "miscTx" when none does
+	Method          string   `json:"method"`
+	MethodSignature string   `json:"methodSignature"`
+	MethodArgs      []string `json:"methodArgs"`
+}
+
+func unwrapSelfCalls(to common.Address, calls [][]byte) []wrappedCall {
+	var unwrapped []wrappedCall
+	for _, data := range calls {
+		unwrapped = append(unwrapped, wrappedCall{To: to, Value: big.NewInt(0), Data: data})
+	}
+	return unwrapped
+}
+
+// (address target, ..., bytes callData) tuples as decoded by the ABI package (slices of anonymous structs), `dataField` is the index of the calldata in the tuple
+func unwrapTargetCalls(calls interface{}, dataField int) []wrappedCall {
+	var unwrapped []wrappedCall
+	rv := reflect.ValueOf(calls)
+	for i := 0; i < rv.Len(); i++ {
+		call := rv.Index(i)
+		unwrapped = append(unwrapped, wrappedCall{
+			To:    call.Field(0).Interface().(common.Address),
+			Value: big.NewInt(0),
+			Data:  call.Field(dataField).Interface().([]byte),
+		})
+	}
+	return unwrapped
+}
+
+// Safe multiSend packs every call as operation (1 byte) + to (20) + value (32) + data length (32) + data
+func unwrapMultiSend(transactions []byte) []wrappedCall {
+	var unwrapped []wrappedCall
+	for offset := 0; offset+85 <= len(transactions); {
+		length := new(big.Int).SetBytes(transactions[offset+53 : offset+85])
+		if !length.IsUint64() || length.Uint64() > uint64(len(transactions)-offset-85) {
+			break
+		}
+		end := offset + 85 + int(length.Uint64())
+		unwrapped = append(unwrapped, wrappedCall{
+			To:           common.BytesToAddress(transactions[offset+1 : offset+21]),
+			Value:        new(big.Int).SetBytes(transactions[offset+21 : offset+53]),
+			Data:         transactions[offset+85 : end],
+			DelegateCall: transactions[offset] == 1,
+		})
+		offset = end
+	}
+	return unwrapped
+}
+
+var callWrappers = func() []*callWrapper {
+	wrappers := []*callWrapper{
+		{
+			Name:      "safeExecTransaction",
+			Signature: "execTransaction(address,uint256,bytes,uint8,uint256,uint256,uint256,address,address,bytes)",
+			unwrap: func(to common.Address, values []interface{}) []wrappedCall {
+				return []wrappedCall{{To: values[0].(common.Address), Value: values[1].(*big.Int), Data: values[2].([]byte), DelegateCall: values[3].(uint8) == 1}}
+			},
+		},
+		{
+			Name:      "safeMultiSend",
+			Signature: "multiSend(bytes)",
+			unwrap: func(to common.Address, values []interface{}) []wrappedCall {
+				return unwrapMultiSend(values[0].([]byte))
+			},
+		},
+		{
+			Name:      "multicall",
+			Signature: "multicall(bytes[])",
+			unwrap: func(to common.Address, values []interface{}) []wrappedCall {
+				return unwrapSelfCalls(to, values[0].([][]byte))
+			},
+		},
+		{
+			// Uniswap SwapRouter02
+			Name:      "multicall",
+			Signature: "multicall(uint256,bytes[])",
+			unwrap: func(to common.Address, values []interface{}) []wrappedCall {
+				return unwrapSelfCalls(to, values[1].([][]byte))
+			},
+		},
+		{
+			// MakerDAO Multicall/Multicall2
+			Name:      "aggregate",
+			Signature: "aggregate((address,bytes)[])",
+			unwrap: func(to common.Address, values []interface{}) []wrappedCall {
+				return unwrapTargetCalls(values[0], 1)
+			},
+		},
+		{
+			Name:      "aggregate",
+			Signature: "tryAggregate(bool,(address,bytes)[])",
+			unwrap: func(to common.Address, values []interface{}) []wrappedCall {
+				return unwrapTargetCalls(values[1], 1)
+			},
+		},
+		{
+			// Multicall3 (target, allowFailure, callData)
+			Name:      "aggregate",
+			Signature: "aggregate3((address,bool,bytes)[])",
+			unwrap: func(to common.Address, values []interface{}) []wrappedCall {
+				return unwrapTargetCalls(values[0], 2)
+			},
+		},
+		{
+			// DSProxy (InstaDapp, DeFi Saver, Maker's proxy actions), runs the target's code in the proxy
+			Name:      "dsProxyExecute",
+			Signature: "execute(address,bytes)",
+			unwrap: func(to common.Address, values []interface{}) []wrappedCall {
+				return []wrappedCall{{To: values[0].(common.Address), Value: big.NewInt(0), Data: values[1].([]byte), DelegateCall: true}}
+			},
+		},
+		{
+			// Generic executors (ERC725X style smart wallets, a lot of bot contracts)
+			Name:      "execute",
+			Signature: "execute(address,uint256,bytes)",
+			unwrap: func(to common.Address, values []interface{}) []wrappedCall {
+				return []wrappedCall{{To: values[0].(common.Address), Value: values[1].(*big.Int), Data: values[2].([]byte)}}
+			},
+		},
+	}
+	for _, wrapper := range wrappers {
+		_, args, err := parseFnSignature(wrapper.Signature)
+		if err != nil {
+			panic(err)
+		}
+		wrapper.args = args
+		wrapper.selector = crypto.Keccak256([]byte(wrapper.Signature))[:4]
+	}
+	return wrappers
+}()
+
+func lookupCallWrapper(data []byte) *callWrapper {
+	if len(data) < 4 {
+		return nil
+	}
+	for _, wrapper := range callWrappers {
+		if bytes.Equal(wrapper.selector, data[:4]) {
+			return wrapper
+		}
+	}
+	return nil
+}
+
+// Unwrap the calls inside `data`, nil if it isn't a known wrapper or doesn't decode against it
+func unwrapCall(to common.Address, data []byte) (wrapper *callWrapper, calls []wrappedCall) {
+	wrapper = lookupCallWrapper(data)
+	if wrapper == nil {
+		return nil, nil
+	}
+	// Same as the 4byte decoder, garbage calldata can make the ABI decoder panic
+	defer func() {
+		if recover() != nil {
+			wrapper, calls = nil, nil
+		}
+	}()
+	values, err := wrapper.args.UnpackValues(data[4:])
+	if err != nil {
+		return nil, nil
+	}
+	return wrapper, wrapper.unwrap(to, values)
+}
+
+// Name of the filter that claims the call, the call is wrapped in an unsigned tx so the same matchers can be used
+func classifyInnerCall(call wrappedCall, client *ethclient.Client) string {
+	if len(call.Data) == 0 {
+		return "directTransfer"
+	}
+	if len(call.Data) < 4 {
+		return "edgeTx"
+	}
+	tx := types.NewTransaction(0, call.To, call.Value, 0, big.NewInt(0), call.Data)
+	if filter, _ := matchTxFilter(tx, client); filter != nil {
+		return filter.Name
+	}
+	return "miscTx"
+}
+
+// Method + args of an inner call, from the ABI registry when the target is known and the 4byte database otherwise
+func decodeInnerCallMethod(call wrappedCall, final *innerCall) {
+	if len(call.Data) < 4 {
+		return
+	}
+	if _, _, contractAbi, ok := lookupContractABI(call.To); ok {
+		if decoded, err := decodeCallWithABI(contractAbi, call.Data); err == nil {
+			final.Method, final.MethodSignature = decoded.Method, decoded.MethodSignature
+			for _, name := range decoded.ArgNames {
+				final.MethodArgs = append(final.MethodArgs, name+"="+decoded.Args[name])
+			}
+			return
+		}
+	}
+	if calls := decodeCallData(call.Data); len(calls) > 0 {
+		final.Method, final.MethodSignature, final.MethodArgs = calls[0].Method, calls[0].Signature, calls[0].Args
+	} else if wrapper := lookupCallWrapper(call.Data); wrapper != nil {
+		// Nested wrappers are listed with their own calls right after them, the signature is enough here
+		final.Method, final.MethodSignature = wrapper.Signature[:strings.Index(wrapper.Signature, "(")], wrapper.Signature
+	}
+}
+
+func decodeInnerCalls(to common.Address, data []byte, depth int, path string, client *ethclient.Client) []innerCall {
+	if depth > nestedCallDepth {
+		return nil
+	}
+	wrapper, calls := unwrapCall(to, data)
+	if wrapper == nil {
+		return nil
+	}
+	var inner []innerCall
+	for i, call := range calls {
+		final := innerCall{
+			Path:         fmt.Sprintf("%s%d", path, i),
+			Depth:        depth,
+			Wrapper:      wrapper.Name,
+			DelegateCall: call.DelegateCall,
+			To:           call.To.Hex(),
+			Value:        formatEthWeiToEther(call.Value),
+			Classifier:   classifyInnerCall(call, client),
+		}
+		decodeInnerCallMethod(call, &final)
+		inner = append(inner, final)
+		inner = append(inner, decodeInnerCalls(call.To, call.Data, depth+1, final.Path+".", client)...)
+	}
+	return inner
+}
+
+// Populates the `innerCalls` document field, empty for anything that isn't a known wrapper
+func getInnerCalls(tx *types.Transaction, client *ethclient.Client) []innerCall {
+	if tx.To() == nil {
+		return []innerCall{}
+	}
+	if inner, ok := innerCallsCache.get(tx.Hash()); ok {
+		return inner.([]innerCall)
+	}
+	inner := decodeInnerCalls(*tx.To(), tx.Data(), 1, "", client)
+	if inner == nil {
+		inner = []innerCall{}
+	}
+	innerCallsCache.add(tx.Hash(), inner)
+	return inner
+}
+
+func printInnerCalls(tx *types.Transaction, client *ethclient.Client) {
+	inner := getInnerCalls(tx, client)
+	if len(inner) == 0 {
+		return
+	}
+	fmt.Println("Inner calls of", tx.Hash().Hex())
+	for _, call := range inner {
+		method := call.Method
+		if method == "" {
+			method = "unknown"
+		}
+		fmt.Println(strings.Repeat("  ", call.Depth), Magenta("["+call.Path+"] "+call.Wrapper+" => "+call.Classifier), call.To, method, call.MethodArgs)
+	}
+}
@@ -0,0 +1,53 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/elastic/go-elasticsearch/v8/esapi"
+)
+
+// Pipe feed level events (not tied to a single tx) into elastic search
+
+func handleChainlinkNextPrice(event chainlinkNextPriceEvent) {
+	// Connect to our es client
+	es, err := elasticsearch.NewDefaultClient()
+	if err != nil {
+		log.Fatalf("Error connecting to es client: %s", err)
+	}
+	body := struct {
+		Type            string                  `json:"feedEventType"`
+		FinalParsedData chainlinkNextPriceEvent `json:"finalParsedData"`
+	}{}
+	body.Type = "chainlinkNextPrice"
+	body.FinalParsedData = event
+	jsonBytes, _ := json.Marshal(body)
+	// Set up the request object.
+	req := esapi.IndexRequest{
+		Index:   "feeds",
+		Body:    bytes.NewReader(jsonBytes),
+		Refresh: "true",
+	}
+	// Perform the request with the client.
+	res, err := req.Do(context.Background(), es)
+	if err != nil {
+		log.Fatalf("Error getting response: %s", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		log.Printf("[%s] Error indexing document", res.Status())
+	} else {
+		// Deserialize the response into a map.
+		var r map[string]interface{}
+		if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+			log.Printf("Error parsing the response body: %s", err)
+		} else {
+			// Print the response status and indexed document version.
+			log.Printf("[%s] %s; version=%d", res.Status(), r["result"], int(r["_version"].(float64)))
+		}
+	}
+}
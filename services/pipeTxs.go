@@ -22,6 +22,26 @@ import (
 	"github.com/taarushv/helios/contracts/erc20"
 )
 
+// Fields every tx document carries next to its own, one place to add the next one
+type documentExtras struct {
+	// LocalLogs are the logs of the txs when executed against the local EVM (latest block, only with -localExec)
+	// Tags are to classify post logs, to track
+	LocalLogs []string `json:"localLogs"`
+	Tags      []string `json:"tags"`
+	// Calls unwrapped from multicall/Safe/proxy wrappers (see nestedCalls.go)
+	InnerCalls []innerCall `json:"innerCalls"`
+	// fromLabel, toLabel and their categories from the address label store (see addressLabels.go)
+	addressLabels
+}
+
+func getDocumentExtras(tx *types.Transaction, client *ethclient.Client, isStealth bool) documentExtras {
+	var extras documentExtras
+	extras.LocalLogs, extras.Tags = getLocalLogsAndTags(tx, client, isStealth)
+	extras.InnerCalls = getInnerCalls(tx, client)
+	extras.addressLabels = getAddressLabels(tx, client)
+	return extras
+}
+
 // Mempool => ES index document
 func handleDirectTransfer(tx *types.Transaction, client *ethclient.Client, isStealth bool) {
 	// Connect to our es client
@@ -47,11 +67,7 @@ func handleDirectTransfer(tx *types.Transaction, client *ethclient.Client, isSte
 		BlockIncluded int64 `json:"blockIncluded"`
 		Failed        bool  `json:"txFailed"`
 		Stealth       bool  `json:"txStealth"`
-		// WIP
-		// LocalLogs are the logs of the txs when executed against the local EVM (ganache fork that's updated every block)
-		// Tags are to classify post logs, to track
-		LocalLogs []string `json:"localLogs"`
-		Tags      []string `json:"tags"`
+		documentExtras
 	}{}
 	body.TimeSeen = time.Now().Unix()
 	body.Hash = tx.Hash().Hex()
@@ -69,7 +85,10 @@ func handleDirectTransfer(tx *types.Transaction, client *ethclient.Client, isSte
 		body.BlockIncluded = getBlockNoByTxHash(tx.Hash().Hex(), client)
 	}
 	body.Stealth = isStealth
+	body.documentExtras = getDocumentExtras(tx, client, isStealth)
 	jsonBytes, _ := json.Marshal(body)
+	jsonBytes = applyRules(tx, client, isStealth, jsonBytes)
+	alertWatchlist(tx, client, isStealth, jsonBytes)
 	// Set up the request object.
 	req := esapi.IndexRequest{
 		Index:   "transactions",
@@ -97,8 +116,7 @@ func handleDirectTransfer(tx *types.Transaction, client *ethclient.Client, isSte
 	}
 }
 
-func handleContractDeployment(tx *types.Transaction, client *ethclient.Client, isStealth bool) {
-	fmt.Println("Contract deployment, TX:", tx.Hash().String())
+func handleContractDeployment(tx *types.Transaction, client *ethclient.Client, isStealth bool, final contractDeployment) {
 	// Connect to our es client
 	es, err := elasticsearch.NewDefaultClient()
 	if err != nil {
@@ -117,20 +135,19 @@ func handleContractDeployment(tx *types.Transaction, client *ethclient.Client, i
 		Nonce    uint64  `json:"nonce"`
 		GasPrice float64 `json:"gasPrice"`
 		Gas      float64 `json:"gas"`
+		// Predicted address, selectors, standards etc (see deploymentAnalysis.go)
+		Deployment contractDeployment `json:"deployment"`
 		// Custom tags that are updated after a block including the tx is mined
 		Mined         bool  `json:"txMined"`
 		BlockIncluded int64 `json:"blockIncluded"`
 		Failed        bool  `json:"txFailed"`
 		Stealth       bool  `json:"txStealth"`
-		// WIP
-		// LocalLogs are the logs of the txs when executed against the local EVM (ganache fork that's updated every block)
-		// Tags are to classify post logs, to track
-		LocalLogs []string `json:"localLogs"`
-		Tags      []string `json:"tags"`
+		documentExtras
 	}{}
 	body.TimeSeen = time.Now().Unix()
 	body.Hash = tx.Hash().Hex()
 	body.Type = "contractDeployment"
+	body.Deployment = final
 	body.From = getTxSenderAddress(tx, client)
 	body.Value = formatEthWeiToEther(tx.Value())
 	body.Nonce = tx.Nonce()
@@ -143,7 +160,10 @@ func handleContractDeployment(tx *types.Transaction, client *ethclient.Client, i
 		body.BlockIncluded = getBlockNoByTxHash(tx.Hash().Hex(), client)
 	}
 	body.Stealth = isStealth
+	body.documentExtras = getDocumentExtras(tx, client, isStealth)
 	jsonBytes, _ := json.Marshal(body)
+	jsonBytes = applyRules(tx, client, isStealth, jsonBytes)
+	alertWatchlist(tx, client, isStealth, jsonBytes)
 	// Set up the request object.
 	req := esapi.IndexRequest{
 		Index:   "transactions",
@@ -219,11 +239,7 @@ func handleERC20Approve(tx *types.Transaction, client *ethclient.Client, isSteal
 			BlockIncluded int64 `json:"blockIncluded"`
 			Failed        bool  `json:"txFailed"`
 			Stealth       bool  `json:"txStealth"`
-			// WIP
-			// LocalLogs are the logs of the txs when executed against the local EVM (ganache fork that's updated every block)
-			// Tags are to classify post logs, to track
-			LocalLogs []string `json:"localLogs"`
-			Tags      []string `json:"tags"`
+			documentExtras
 		}{}
 		body.TimeSeen = time.Now().Unix()
 		body.Hash = tx.Hash().Hex()
@@ -242,7 +258,10 @@ func handleERC20Approve(tx *types.Transaction, client *ethclient.Client, isSteal
 			body.BlockIncluded = getBlockNoByTxHash(tx.Hash().Hex(), client)
 		}
 		body.Stealth = isStealth
+		body.documentExtras = getDocumentExtras(tx, client, isStealth)
 		jsonBytes, _ := json.Marshal(body)
+		jsonBytes = applyRules(tx, client, isStealth, jsonBytes)
+		alertWatchlist(tx, client, isStealth, jsonBytes)
 		// Set up the request object.
 		req := esapi.IndexRequest{
 			Index:   "transactions",
@@ -314,11 +333,7 @@ func handleERC20Transfer(tx *types.Transaction, client *ethclient.Client, isStea
 			BlockIncluded int64 `json:"blockIncluded"`
 			Failed        bool  `json:"txFailed"`
 			Stealth       bool  `json:"txStealth"`
-			// WIP
-			// LocalLogs are the logs of the txs when executed against the local EVM (ganache fork that's updated every block)
-			// Tags are to classify post logs, to track
-			LocalLogs []string `json:"localLogs"`
-			Tags      []string `json:"tags"`
+			documentExtras
 		}{}
 		body.TimeSeen = time.Now().Unix()
 		body.Hash = tx.Hash().Hex()
@@ -337,7 +352,10 @@ func handleERC20Transfer(tx *types.Transaction, client *ethclient.Client, isStea
 			body.BlockIncluded = getBlockNoByTxHash(tx.Hash().Hex(), client)
 		}
 		body.Stealth = isStealth
+		body.documentExtras = getDocumentExtras(tx, client, isStealth)
 		jsonBytes, _ := json.Marshal(body)
+		jsonBytes = applyRules(tx, client, isStealth, jsonBytes)
+		alertWatchlist(tx, client, isStealth, jsonBytes)
 		// Set up the request object.
 		req := esapi.IndexRequest{
 			Index:   "transactions",
@@ -393,11 +411,7 @@ func handleLinkOracleUpdate(tx *types.Transaction, client *ethclient.Client, isS
 		BlockIncluded int64 `json:"blockIncluded"`
 		Failed        bool  `json:"txFailed"`
 		Stealth       bool  `json:"txStealth"`
-		// WIP
-		// LocalLogs are the logs of the txs when executed against the local EVM (ganache fork that's updated every block)
-		// Tags are to classify post logs, to track
-		LocalLogs []string `json:"localLogs"`
-		Tags      []string `json:"tags"`
+		documentExtras
 	}{}
 	body.TimeSeen = time.Now().Unix()
 	body.Hash = tx.Hash().Hex()
@@ -416,7 +430,162 @@ func handleLinkOracleUpdate(tx *types.Transaction, client *ethclient.Client, isS
 		body.BlockIncluded = getBlockNoByTxHash(tx.Hash().Hex(), client)
 	}
 	body.Stealth = isStealth
+	body.documentExtras = getDocumentExtras(tx, client, isStealth)
+	jsonBytes, _ := json.Marshal(body)
+	jsonBytes = applyRules(tx, client, isStealth, jsonBytes)
+	alertWatchlist(tx, client, isStealth, jsonBytes)
+	// Set up the request object.
+	req := esapi.IndexRequest{
+		Index:   "transactions",
+		Body:    bytes.NewReader(jsonBytes),
+		Refresh: "true",
+	}
+	// Perform the request with the client.
+	res, err := req.Do(context.Background(), es)
+	if err != nil {
+		log.Fatalf("Error getting response: %s", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		log.Printf("[%s] Error indexing document", res.Status())
+	} else {
+		// Deserialize the response into a map.
+		var r map[string]interface{}
+		if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+			log.Printf("Error parsing the response body: %s", err)
+		} else {
+			// Print the response status and indexed document version.
+			log.Printf("[%s] %s; version=%d", res.Status(), r["result"], int(r["_version"].(float64)))
+		}
+	}
+}
+
+func handleDydxFundingRate(tx *types.Transaction, client *ethclient.Client, isStealth bool, final dydxFundingRateUpdate) {
+
+	// Connect to our es client
+	es, err := elasticsearch.NewDefaultClient()
+	if err != nil {
+		log.Fatalf("Error connecting to es client: %s", err)
+	}
+	// Start building a document
+	body := struct {
+		// Time the tx was discovered and other details (for data analysis + Kibana)
+		TimeSeen int64  `json:"timeFirstDiscovered"`
+		Hash     string `json:"txHash"`
+		// Classifcation and other info
+		Type            string                `json:"txType"`
+		FinalParsedData dydxFundingRateUpdate `json:"finalParsedData"`
+		From            string                `json:"from"`
+		To              string                `json:"to"`
+		Value           float64               `json:"txValue"`
+		Nonce           uint64                `json:"nonce"`
+		GasPrice        float64               `json:"gasPrice"`
+		Gas             float64               `json:"gas"`
+		// Custom tags that are updated after a block including the tx is mined
+		Mined         bool  `json:"txMined"`
+		BlockIncluded int64 `json:"blockIncluded"`
+		Failed        bool  `json:"txFailed"`
+		Stealth       bool  `json:"txStealth"`
+		documentExtras
+	}{}
+	body.TimeSeen = time.Now().Unix()
+	body.Hash = tx.Hash().Hex()
+	body.Type = "dydxFundingRateUpdate"
+	body.FinalParsedData = final
+	body.From = getTxSenderAddress(tx, client)
+	body.To = tx.To().Hex()
+	body.Value = formatEthWeiToEther(tx.Value())
+	body.Nonce = tx.Nonce()
+	formattedGas := new(big.Int).SetUint64(tx.Gas())
+	body.Gas = formatEthWeiToEther(formattedGas)
+	body.GasPrice = formatEthWeiToEther(tx.GasPrice())
+	body.Mined = isTxMined(tx.Hash().Hex(), client)
+	body.Failed = hasTxFailed(tx.Hash().Hex(), client)
+	if isStealth {
+		body.BlockIncluded = getBlockNoByTxHash(tx.Hash().Hex(), client)
+	}
+	body.Stealth = isStealth
+	body.documentExtras = getDocumentExtras(tx, client, isStealth)
+	jsonBytes, _ := json.Marshal(body)
+	jsonBytes = applyRules(tx, client, isStealth, jsonBytes)
+	alertWatchlist(tx, client, isStealth, jsonBytes)
+	// Set up the request object.
+	req := esapi.IndexRequest{
+		Index:   "transactions",
+		Body:    bytes.NewReader(jsonBytes),
+		Refresh: "true",
+	}
+	// Perform the request with the client.
+	res, err := req.Do(context.Background(), es)
+	if err != nil {
+		log.Fatalf("Error getting response: %s", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		log.Printf("[%s] Error indexing document", res.Status())
+	} else {
+		// Deserialize the response into a map.
+		var r map[string]interface{}
+		if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+			log.Printf("Error parsing the response body: %s", err)
+		} else {
+			// Print the response status and indexed document version.
+			log.Printf("[%s] %s; version=%d", res.Status(), r["result"], int(r["_version"].(float64)))
+		}
+	}
+}
+
+func handleMakerOracleUpdate(tx *types.Transaction, client *ethclient.Client, isStealth bool, final makerOracleUpdate) {
+
+	// Connect to our es client
+	es, err := elasticsearch.NewDefaultClient()
+	if err != nil {
+		log.Fatalf("Error connecting to es client: %s", err)
+	}
+	// Start building a document
+	body := struct {
+		// Time the tx was discovered and other details (for data analysis + Kibana)
+		TimeSeen int64  `json:"timeFirstDiscovered"`
+		Hash     string `json:"txHash"`
+		// Classifcation and other info
+		Type            string            `json:"txType"`
+		FinalParsedData makerOracleUpdate `json:"finalParsedData"`
+		From            string            `json:"from"`
+		To              string            `json:"to"`
+		Value           float64           `json:"txValue"`
+		Nonce           uint64            `json:"nonce"`
+		GasPrice        float64           `json:"gasPrice"`
+		Gas             float64           `json:"gas"`
+		// Custom tags that are updated after a block including the tx is mined
+		Mined         bool  `json:"txMined"`
+		BlockIncluded int64 `json:"blockIncluded"`
+		Failed        bool  `json:"txFailed"`
+		Stealth       bool  `json:"txStealth"`
+		documentExtras
+	}{}
+	body.TimeSeen = time.Now().Unix()
+	body.Hash = tx.Hash().Hex()
+	body.Type = "makerOracleUpdate"
+	body.FinalParsedData = final
+	body.From = getTxSenderAddress(tx, client)
+	body.To = tx.To().Hex()
+	body.Value = formatEthWeiToEther(tx.Value())
+	body.Nonce = tx.Nonce()
+	formattedGas := new(big.Int).SetUint64(tx.Gas())
+	body.Gas = formatEthWeiToEther(formattedGas)
+	body.GasPrice = formatEthWeiToEther(tx.GasPrice())
+	body.Mined = isTxMined(tx.Hash().Hex(), client)
+	body.Failed = hasTxFailed(tx.Hash().Hex(), client)
+	if isStealth {
+		body.BlockIncluded = getBlockNoByTxHash(tx.Hash().Hex(), client)
+	}
+	body.Stealth = isStealth
+	body.documentExtras = getDocumentExtras(tx, client, isStealth)
 	jsonBytes, _ := json.Marshal(body)
+	jsonBytes = applyRules(tx, client, isStealth, jsonBytes)
+	alertWatchlist(tx, client, isStealth, jsonBytes)
 	// Set up the request object.
 	req := esapi.IndexRequest{
 		Index:   "transactions",
@@ -470,11 +639,7 @@ func handleUniAddETHLiq(tx *types.Transaction, client *ethclient.Client, isSteal
 		BlockIncluded int64 `json:"blockIncluded"`
 		Failed        bool  `json:"txFailed"`
 		Stealth       bool  `json:"txStealth"`
-		// WIP
-		// LocalLogs are the logs of the txs when executed against the local EVM (ganache fork that's updated every block)
-		// Tags are to classify post logs, to track
-		LocalLogs []string `json:"localLogs"`
-		Tags      []string `json:"tags"`
+		documentExtras
 	}{}
 	body.TimeSeen = time.Now().Unix()
 	body.Hash = tx.Hash().Hex()
@@ -493,7 +658,10 @@ func handleUniAddETHLiq(tx *types.Transaction, client *ethclient.Client, isSteal
 		body.BlockIncluded = getBlockNoByTxHash(tx.Hash().Hex(), client)
 	}
 	body.Stealth = isStealth
+	body.documentExtras = getDocumentExtras(tx, client, isStealth)
 	jsonBytes, _ := json.Marshal(body)
+	jsonBytes = applyRules(tx, client, isStealth, jsonBytes)
+	alertWatchlist(tx, client, isStealth, jsonBytes)
 	// Set up the request object.
 	req := esapi.IndexRequest{
 		Index:   "transactions",
@@ -547,11 +715,7 @@ func handleUniAddLiq(tx *types.Transaction, client *ethclient.Client, isStealth
 		BlockIncluded int64 `json:"blockIncluded"`
 		Failed        bool  `json:"txFailed"`
 		Stealth       bool  `json:"txStealth"`
-		// WIP
-		// LocalLogs are the logs of the txs when executed against the local EVM (ganache fork that's updated every block)
-		// Tags are to classify post logs, to track
-		LocalLogs []string `json:"localLogs"`
-		Tags      []string `json:"tags"`
+		documentExtras
 	}{}
 	body.TimeSeen = time.Now().Unix()
 	body.Hash = tx.Hash().Hex()
@@ -570,7 +734,10 @@ func handleUniAddLiq(tx *types.Transaction, client *ethclient.Client, isStealth
 		body.BlockIncluded = getBlockNoByTxHash(tx.Hash().Hex(), client)
 	}
 	body.Stealth = isStealth
+	body.documentExtras = getDocumentExtras(tx, client, isStealth)
 	jsonBytes, _ := json.Marshal(body)
+	jsonBytes = applyRules(tx, client, isStealth, jsonBytes)
+	alertWatchlist(tx, client, isStealth, jsonBytes)
 	// Set up the request object.
 	req := esapi.IndexRequest{
 		Index:   "transactions",
@@ -623,11 +790,7 @@ func handleUniRemoveETHLiq(tx *types.Transaction, client *ethclient.Client, isSt
 		BlockIncluded int64 `json:"blockIncluded"`
 		Failed        bool  `json:"txFailed"`
 		Stealth       bool  `json:"txStealth"`
-		// WIP
-		// LocalLogs are the logs of the txs when executed against the local EVM (ganache fork that's updated every block)
-		// Tags are to classify post logs, to track
-		LocalLogs []string `json:"localLogs"`
-		Tags      []string `json:"tags"`
+		documentExtras
 	}{}
 	body.TimeSeen = time.Now().Unix()
 	body.Hash = tx.Hash().Hex()
@@ -646,7 +809,10 @@ func handleUniRemoveETHLiq(tx *types.Transaction, client *ethclient.Client, isSt
 		body.BlockIncluded = getBlockNoByTxHash(tx.Hash().Hex(), client)
 	}
 	body.Stealth = isStealth
+	body.documentExtras = getDocumentExtras(tx, client, isStealth)
 	jsonBytes, _ := json.Marshal(body)
+	jsonBytes = applyRules(tx, client, isStealth, jsonBytes)
+	alertWatchlist(tx, client, isStealth, jsonBytes)
 	// Set up the request object.
 	req := esapi.IndexRequest{
 		Index:   "transactions",
@@ -700,11 +866,7 @@ func handleUniRemoveLiq(tx *types.Transaction, client *ethclient.Client, isSteal
 		BlockIncluded int64 `json:"blockIncluded"`
 		Failed        bool  `json:"txFailed"`
 		Stealth       bool  `json:"txStealth"`
-		// WIP
-		// LocalLogs are the logs of the txs when executed against the local EVM (ganache fork that's updated every block)
-		// Tags are to classify post logs, to track
-		LocalLogs []string `json:"localLogs"`
-		Tags      []string `json:"tags"`
+		documentExtras
 	}{}
 	body.TimeSeen = time.Now().Unix()
 	body.Hash = tx.Hash().Hex()
@@ -723,7 +885,10 @@ func handleUniRemoveLiq(tx *types.Transaction, client *ethclient.Client, isSteal
 		body.BlockIncluded = getBlockNoByTxHash(tx.Hash().Hex(), client)
 	}
 	body.Stealth = isStealth
+	body.documentExtras = getDocumentExtras(tx, client, isStealth)
 	jsonBytes, _ := json.Marshal(body)
+	jsonBytes = applyRules(tx, client, isStealth, jsonBytes)
+	alertWatchlist(tx, client, isStealth, jsonBytes)
 	// Set up the request object.
 	req := esapi.IndexRequest{
 		Index:   "transactions",
@@ -777,15 +942,12 @@ func handleUniFinalTrade(tx *types.Transaction, client *ethclient.Client, isStea
 		BlockIncluded int64 `json:"blockIncluded"`
 		Failed        bool  `json:"txFailed"`
 		Stealth       bool  `json:"txStealth"`
-		// WIP
-		// LocalLogs are the logs of the txs when executed against the local EVM (ganache fork that's updated every block)
-		// Tags are to classify post logs, to track
-		LocalLogs []string `json:"localLogs"`
-		Tags      []string `json:"tags"`
+		documentExtras
 	}{}
 	body.TimeSeen = time.Now().Unix()
 	body.Hash = tx.Hash().Hex()
 	body.Type = "uniswapTrade"
+	final.AmountInUSD = getTradeAmountInUSD(final, client)
 	body.FinalParsedData = final
 	body.From = getTxSenderAddress(tx, client)
 	body.To = tx.To().Hex()
@@ -800,7 +962,10 @@ func handleUniFinalTrade(tx *types.Transaction, client *ethclient.Client, isStea
 		body.BlockIncluded = getBlockNoByTxHash(tx.Hash().Hex(), client)
 	}
 	body.Stealth = isStealth
+	body.documentExtras = getDocumentExtras(tx, client, isStealth)
 	jsonBytes, _ := json.Marshal(body)
+	jsonBytes = applyRules(tx, client, isStealth, jsonBytes)
+	alertWatchlist(tx, client, isStealth, jsonBytes)
 	// Set up the request object.
 	req := esapi.IndexRequest{
 		Index:   "transactions",
@@ -828,7 +993,8 @@ func handleUniFinalTrade(tx *types.Transaction, client *ethclient.Client, isStea
 	}
 }
 
-func handleMiscTx(tx *types.Transaction, client *ethclient.Client, isStealth bool) {
+func handleAggregatorFinalTrade(tx *types.Transaction, client *ethclient.Client, isStealth bool, final UniswapTradeFinal) {
+
 	// Connect to our es client
 	es, err := elasticsearch.NewDefaultClient()
 	if err != nil {
@@ -840,27 +1006,26 @@ func handleMiscTx(tx *types.Transaction, client *ethclient.Client, isStealth boo
 		TimeSeen int64  `json:"timeFirstDiscovered"`
 		Hash     string `json:"txHash"`
 		// Classifcation and other info
-		Type     string  `json:"txType"`
-		From     string  `json:"from"`
-		To       string  `json:"to"`
-		Value    float64 `json:"txValue"`
-		Nonce    uint64  `json:"nonce"`
-		GasPrice float64 `json:"gasPrice"`
-		Gas      float64 `json:"gas"`
+		Type            string            `json:"txType"`
+		FinalParsedData UniswapTradeFinal `json:"finalParsedData"`
+		From            string            `json:"from"`
+		To              string            `json:"to"`
+		Value           float64           `json:"txValue"`
+		Nonce           uint64            `json:"nonce"`
+		GasPrice        float64           `json:"gasPrice"`
+		Gas             float64           `json:"gas"`
 		// Custom tags that are updated after a block including the tx is mined
 		Mined         bool  `json:"txMined"`
 		BlockIncluded int64 `json:"blockIncluded"`
 		Failed        bool  `json:"txFailed"`
 		Stealth       bool  `json:"txStealth"`
-		// WIP
-		// LocalLogs are the logs of the txs when executed against the local EVM (ganache fork that's updated every block)
-		// Tags are to classify post logs, to track
-		LocalLogs []string `json:"localLogs"`
-		Tags      []string `json:"tags"`
+		documentExtras
 	}{}
 	body.TimeSeen = time.Now().Unix()
 	body.Hash = tx.Hash().Hex()
-	body.Type = "miscTx"
+	body.Type = "aggregatorTrade"
+	final.AmountInUSD = getTradeAmountInUSD(final, client)
+	body.FinalParsedData = final
 	body.From = getTxSenderAddress(tx, client)
 	body.To = tx.To().Hex()
 	body.Value = formatEthWeiToEther(tx.Value())
@@ -874,7 +1039,10 @@ func handleMiscTx(tx *types.Transaction, client *ethclient.Client, isStealth boo
 		body.BlockIncluded = getBlockNoByTxHash(tx.Hash().Hex(), client)
 	}
 	body.Stealth = isStealth
+	body.documentExtras = getDocumentExtras(tx, client, isStealth)
 	jsonBytes, _ := json.Marshal(body)
+	jsonBytes = applyRules(tx, client, isStealth, jsonBytes)
+	alertWatchlist(tx, client, isStealth, jsonBytes)
 	// Set up the request object.
 	req := esapi.IndexRequest{
 		Index:   "transactions",
@@ -902,7 +1070,84 @@ func handleMiscTx(tx *types.Transaction, client *ethclient.Client, isStealth boo
 	}
 }
 
-func handleEdgeTx(tx *types.Transaction, client *ethclient.Client, isStealth bool) {
+func handlePoolFinalTrade(tx *types.Transaction, client *ethclient.Client, isStealth bool, final UniswapTradeFinal) {
+
+	// Connect to our es client
+	es, err := elasticsearch.NewDefaultClient()
+	if err != nil {
+		log.Fatalf("Error connecting to es client: %s", err)
+	}
+	// Start building a document
+	body := struct {
+		// Time the tx was discovered and other details (for data analysis + Kibana)
+		TimeSeen int64  `json:"timeFirstDiscovered"`
+		Hash     string `json:"txHash"`
+		// Classifcation and other info
+		Type            string            `json:"txType"`
+		FinalParsedData UniswapTradeFinal `json:"finalParsedData"`
+		From            string            `json:"from"`
+		To              string            `json:"to"`
+		Value           float64           `json:"txValue"`
+		Nonce           uint64            `json:"nonce"`
+		GasPrice        float64           `json:"gasPrice"`
+		Gas             float64           `json:"gas"`
+		// Custom tags that are updated after a block including the tx is mined
+		Mined         bool  `json:"txMined"`
+		BlockIncluded int64 `json:"blockIncluded"`
+		Failed        bool  `json:"txFailed"`
+		Stealth       bool  `json:"txStealth"`
+		documentExtras
+	}{}
+	body.TimeSeen = time.Now().Unix()
+	body.Hash = tx.Hash().Hex()
+	body.Type = "poolTrade"
+	final.AmountInUSD = getTradeAmountInUSD(final, client)
+	body.FinalParsedData = final
+	body.From = getTxSenderAddress(tx, client)
+	body.To = tx.To().Hex()
+	body.Value = formatEthWeiToEther(tx.Value())
+	body.Nonce = tx.Nonce()
+	formattedGas := new(big.Int).SetUint64(tx.Gas())
+	body.Gas = formatEthWeiToEther(formattedGas)
+	body.GasPrice = formatEthWeiToEther(tx.GasPrice())
+	body.Mined = isTxMined(tx.Hash().Hex(), client)
+	body.Failed = hasTxFailed(tx.Hash().Hex(), client)
+	if isStealth {
+		body.BlockIncluded = getBlockNoByTxHash(tx.Hash().Hex(), client)
+	}
+	body.Stealth = isStealth
+	body.documentExtras = getDocumentExtras(tx, client, isStealth)
+	jsonBytes, _ := json.Marshal(body)
+	jsonBytes = applyRules(tx, client, isStealth, jsonBytes)
+	alertWatchlist(tx, client, isStealth, jsonBytes)
+	// Set up the request object.
+	req := esapi.IndexRequest{
+		Index:   "transactions",
+		Body:    bytes.NewReader(jsonBytes),
+		Refresh: "true",
+	}
+	// Perform the request with the client.
+	res, err := req.Do(context.Background(), es)
+	if err != nil {
+		log.Fatalf("Error getting response: %s", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		log.Printf("[%s] Error indexing document", res.Status())
+	} else {
+		// Deserialize the response into a map.
+		var r map[string]interface{}
+		if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+			log.Printf("Error parsing the response body: %s", err)
+		} else {
+			// Print the response status and indexed document version.
+			log.Printf("[%s] %s; version=%d", res.Status(), r["result"], int(r["_version"].(float64)))
+		}
+	}
+}
+
+func handleMiscTx(tx *types.Transaction, client *ethclient.Client, isStealth bool) {
 	// Connect to our es client
 	es, err := elasticsearch.NewDefaultClient()
 	if err != nil {
@@ -921,20 +1166,711 @@ func handleEdgeTx(tx *types.Transaction, client *ethclient.Client, isStealth boo
 		Nonce    uint64  `json:"nonce"`
 		GasPrice float64 `json:"gasPrice"`
 		Gas      float64 `json:"gas"`
+		// Best guess at the method from the offline 4byte database (see fourBytes.go), empty if the selector is unknown
+		Method           string   `json:"method"`
+		MethodSignature  string   `json:"methodSignature"`
+		MethodArgs       []string `json:"methodArgs"`
+		MethodCandidates []string `json:"methodCandidates"` // Other colliding signatures the calldata also decodes against
+		// Implementation behind the recipient when it's an upgradeable proxy (see proxyResolver.go)
+		Implementation string `json:"implementation"`
 		// Custom tags that are updated after a block including the tx is mined
 		Mined         bool  `json:"txMined"`
 		BlockIncluded int64 `json:"blockIncluded"`
 		Failed        bool  `json:"txFailed"`
 		Stealth       bool  `json:"txStealth"`
-		// WIP
-		// LocalLogs are the logs of the txs when executed against the local EVM (ganache fork that's updated every block)
-		// Tags are to classify post logs, to track
-		LocalLogs []string `json:"localLogs"`
-		Tags      []string `json:"tags"`
+		documentExtras
 	}{}
 	body.TimeSeen = time.Now().Unix()
 	body.Hash = tx.Hash().Hex()
-	body.Type = "edgeTx"
+	body.Type = "miscTx"
+	if calls := decodeCallData(tx.Data()); len(calls) > 0 {
+		body.Method, body.MethodSignature, body.MethodArgs = calls[0].Method, calls[0].Signature, calls[0].Args
+		for _, call := range calls[1:] {
+			body.MethodCandidates = append(body.MethodCandidates, call.Signature)
+		}
+	}
+	body.From = getTxSenderAddress(tx, client)
+	body.To = tx.To().Hex()
+	body.Implementation = getProxyImplementation(*tx.To(), client)
+	body.Value = formatEthWeiToEther(tx.Value())
+	body.Nonce = tx.Nonce()
+	formattedGas := new(big.Int).SetUint64(tx.Gas())
+	body.Gas = formatEthWeiToEther(formattedGas)
+	body.GasPrice = formatEthWeiToEther(tx.GasPrice())
+	body.Mined = isTxMined(tx.Hash().Hex(), client)
+	body.Failed = hasTxFailed(tx.Hash().Hex(), client)
+	if isStealth {
+		body.BlockIncluded = getBlockNoByTxHash(tx.Hash().Hex(), client)
+	}
+	body.Stealth = isStealth
+	body.documentExtras = getDocumentExtras(tx, client, isStealth)
+	jsonBytes, _ := json.Marshal(body)
+	jsonBytes = applyRules(tx, client, isStealth, jsonBytes)
+	alertWatchlist(tx, client, isStealth, jsonBytes)
+	// Set up the request object.
+	req := esapi.IndexRequest{
+		Index:   "transactions",
+		Body:    bytes.NewReader(jsonBytes),
+		Refresh: "true",
+	}
+	// Perform the request with the client.
+	res, err := req.Do(context.Background(), es)
+	if err != nil {
+		log.Fatalf("Error getting response: %s", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		log.Printf("[%s] Error indexing document", res.Status())
+	} else {
+		// Deserialize the response into a map.
+		var r map[string]interface{}
+		if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+			log.Printf("Error parsing the response body: %s", err)
+		} else {
+			// Print the response status and indexed document version.
+			log.Printf("[%s] %s; version=%d", res.Status(), r["result"], int(r["_version"].(float64)))
+		}
+	}
+}
+
+func handleEdgeTx(tx *types.Transaction, client *ethclient.Client, isStealth bool) {
+	// Connect to our es client
+	es, err := elasticsearch.NewDefaultClient()
+	if err != nil {
+		log.Fatalf("Error connecting to es client: %s", err)
+	}
+	// Start building a document
+	body := struct {
+		// Time the tx was discovered and other details (for data analysis + Kibana)
+		TimeSeen int64  `json:"timeFirstDiscovered"`
+		Hash     string `json:"txHash"`
+		// Classifcation and other info
+		Type     string  `json:"txType"`
+		From     string  `json:"from"`
+		To       string  `json:"to"`
+		Value    float64 `json:"txValue"`
+		Nonce    uint64  `json:"nonce"`
+		GasPrice float64 `json:"gasPrice"`
+		Gas      float64 `json:"gas"`
+		// Custom tags that are updated after a block including the tx is mined
+		Mined         bool  `json:"txMined"`
+		BlockIncluded int64 `json:"blockIncluded"`
+		Failed        bool  `json:"txFailed"`
+		Stealth       bool  `json:"txStealth"`
+		documentExtras
+	}{}
+	body.TimeSeen = time.Now().Unix()
+	body.Hash = tx.Hash().Hex()
+	body.Type = "edgeTx"
+	body.From = getTxSenderAddress(tx, client)
+	body.To = tx.To().Hex()
+	body.Value = formatEthWeiToEther(tx.Value())
+	body.Nonce = tx.Nonce()
+	formattedGas := new(big.Int).SetUint64(tx.Gas())
+	body.Gas = formatEthWeiToEther(formattedGas)
+	body.GasPrice = formatEthWeiToEther(tx.GasPrice())
+	body.Mined = isTxMined(tx.Hash().Hex(), client)
+	body.Failed = hasTxFailed(tx.Hash().Hex(), client)
+	if isStealth {
+		body.BlockIncluded = getBlockNoByTxHash(tx.Hash().Hex(), client)
+	}
+	body.Stealth = isStealth
+	body.documentExtras = getDocumentExtras(tx, client, isStealth)
+	jsonBytes, _ := json.Marshal(body)
+	jsonBytes = applyRules(tx, client, isStealth, jsonBytes)
+	alertWatchlist(tx, client, isStealth, jsonBytes)
+	// Set up the request object.
+	req := esapi.IndexRequest{
+		Index:   "transactions",
+		Body:    bytes.NewReader(jsonBytes),
+		Refresh: "true",
+	}
+	// Perform the request with the client.
+	res, err := req.Do(context.Background(), es)
+	if err != nil {
+		log.Fatalf("Error getting response: %s", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		log.Printf("[%s] Error indexing document", res.Status())
+	} else {
+		// Deserialize the response into a map.
+		var r map[string]interface{}
+		if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+			log.Printf("Error parsing the response body: %s", err)
+		} else {
+			// Print the response status and indexed document version.
+			log.Printf("[%s] %s; version=%d", res.Status(), r["result"], int(r["_version"].(float64)))
+		}
+	}
+}
+
+func handleLendingAction(tx *types.Transaction, client *ethclient.Client, isStealth bool, final lendingAction) {
+
+	// Connect to our es client
+	es, err := elasticsearch.NewDefaultClient()
+	if err != nil {
+		log.Fatalf("Error connecting to es client: %s", err)
+	}
+	// Start building a document
+	body := struct {
+		// Time the tx was discovered and other details (for data analysis + Kibana)
+		TimeSeen int64  `json:"timeFirstDiscovered"`
+		Hash     string `json:"txHash"`
+		// Classifcation and other info
+		Type            string        `json:"txType"`
+		FinalParsedData lendingAction `json:"finalParsedData"`
+		From            string        `json:"from"`
+		To              string        `json:"to"`
+		Value           float64       `json:"txValue"`
+		Nonce           uint64        `json:"nonce"`
+		GasPrice        float64       `json:"gasPrice"`
+		Gas             float64       `json:"gas"`
+		// Custom tags that are updated after a block including the tx is mined
+		Mined         bool  `json:"txMined"`
+		BlockIncluded int64 `json:"blockIncluded"`
+		Failed        bool  `json:"txFailed"`
+		Stealth       bool  `json:"txStealth"`
+		documentExtras
+	}{}
+	body.TimeSeen = time.Now().Unix()
+	body.Hash = tx.Hash().Hex()
+	body.Type = "lendingAction"
+	body.FinalParsedData = final
+	body.From = getTxSenderAddress(tx, client)
+	body.To = tx.To().Hex()
+	body.Value = formatEthWeiToEther(tx.Value())
+	body.Nonce = tx.Nonce()
+	formattedGas := new(big.Int).SetUint64(tx.Gas())
+	body.Gas = formatEthWeiToEther(formattedGas)
+	body.GasPrice = formatEthWeiToEther(tx.GasPrice())
+	body.Mined = isTxMined(tx.Hash().Hex(), client)
+	body.Failed = hasTxFailed(tx.Hash().Hex(), client)
+	if isStealth {
+		body.BlockIncluded = getBlockNoByTxHash(tx.Hash().Hex(), client)
+	}
+	body.Stealth = isStealth
+	body.documentExtras = getDocumentExtras(tx, client, isStealth)
+	jsonBytes, _ := json.Marshal(body)
+	jsonBytes = applyRules(tx, client, isStealth, jsonBytes)
+	alertWatchlist(tx, client, isStealth, jsonBytes)
+	// Set up the request object.
+	req := esapi.IndexRequest{
+		Index:   "transactions",
+		Body:    bytes.NewReader(jsonBytes),
+		Refresh: "true",
+	}
+	// Perform the request with the client.
+	res, err := req.Do(context.Background(), es)
+	if err != nil {
+		log.Fatalf("Error getting response: %s", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		log.Printf("[%s] Error indexing document", res.Status())
+	} else {
+		// Deserialize the response into a map.
+		var r map[string]interface{}
+		if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+			log.Printf("Error parsing the response body: %s", err)
+		} else {
+			// Print the response status and indexed document version.
+			log.Printf("[%s] %s; version=%d", res.Status(), r["result"], int(r["_version"].(float64)))
+		}
+	}
+}
+
+func handleLiquidation(tx *types.Transaction, client *ethclient.Client, isStealth bool, final lendingLiquidation) {
+
+	// Connect to our es client
+	es, err := elasticsearch.NewDefaultClient()
+	if err != nil {
+		log.Fatalf("Error connecting to es client: %s", err)
+	}
+	// Start building a document
+	body := struct {
+		// Time the tx was discovered and other details (for data analysis + Kibana)
+		TimeSeen int64  `json:"timeFirstDiscovered"`
+		Hash     string `json:"txHash"`
+		// Classifcation and other info
+		Type            string             `json:"txType"`
+		FinalParsedData lendingLiquidation `json:"finalParsedData"`
+		From            string             `json:"from"`
+		To              string             `json:"to"`
+		Value           float64            `json:"txValue"`
+		Nonce           uint64             `json:"nonce"`
+		GasPrice        float64            `json:"gasPrice"`
+		Gas             float64            `json:"gas"`
+		// Custom tags that are updated after a block including the tx is mined
+		Mined         bool  `json:"txMined"`
+		BlockIncluded int64 `json:"blockIncluded"`
+		Failed        bool  `json:"txFailed"`
+		Stealth       bool  `json:"txStealth"`
+		documentExtras
+	}{}
+	body.TimeSeen = time.Now().Unix()
+	body.Hash = tx.Hash().Hex()
+	body.Type = "liquidation"
+	body.FinalParsedData = final
+	body.From = getTxSenderAddress(tx, client)
+	body.To = tx.To().Hex()
+	body.Value = formatEthWeiToEther(tx.Value())
+	body.Nonce = tx.Nonce()
+	formattedGas := new(big.Int).SetUint64(tx.Gas())
+	body.Gas = formatEthWeiToEther(formattedGas)
+	body.GasPrice = formatEthWeiToEther(tx.GasPrice())
+	body.Mined = isTxMined(tx.Hash().Hex(), client)
+	body.Failed = hasTxFailed(tx.Hash().Hex(), client)
+	if isStealth {
+		body.BlockIncluded = getBlockNoByTxHash(tx.Hash().Hex(), client)
+	}
+	body.Stealth = isStealth
+	body.documentExtras = getDocumentExtras(tx, client, isStealth)
+	jsonBytes, _ := json.Marshal(body)
+	jsonBytes = applyRules(tx, client, isStealth, jsonBytes)
+	alertWatchlist(tx, client, isStealth, jsonBytes)
+	// Set up the request object.
+	req := esapi.IndexRequest{
+		Index:   "transactions",
+		Body:    bytes.NewReader(jsonBytes),
+		Refresh: "true",
+	}
+	// Perform the request with the client.
+	res, err := req.Do(context.Background(), es)
+	if err != nil {
+		log.Fatalf("Error getting response: %s", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		log.Printf("[%s] Error indexing document", res.Status())
+	} else {
+		// Deserialize the response into a map.
+		var r map[string]interface{}
+		if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+			log.Printf("Error parsing the response body: %s", err)
+		} else {
+			// Print the response status and indexed document version.
+			log.Printf("[%s] %s; version=%d", res.Status(), r["result"], int(r["_version"].(float64)))
+		}
+	}
+}
+
+func handleERC20TransferFrom(tx *types.Transaction, client *ethclient.Client, isStealth bool, final tokenTransfer) {
+
+	// Connect to our es client
+	es, err := elasticsearch.NewDefaultClient()
+	if err != nil {
+		log.Fatalf("Error connecting to es client: %s", err)
+	}
+	// Start building a document
+	body := struct {
+		// Time the tx was discovered and other details (for data analysis + Kibana)
+		TimeSeen int64  `json:"timeFirstDiscovered"`
+		Hash     string `json:"txHash"`
+		// Classifcation and other info
+		Type            string        `json:"txType"`
+		FinalParsedData tokenTransfer `json:"finalParsedData"`
+		From            string        `json:"from"`
+		To              string        `json:"to"`
+		Value           float64       `json:"txValue"`
+		Nonce           uint64        `json:"nonce"`
+		GasPrice        float64       `json:"gasPrice"`
+		Gas             float64       `json:"gas"`
+		// Custom tags that are updated after a block including the tx is mined
+		Mined         bool  `json:"txMined"`
+		BlockIncluded int64 `json:"blockIncluded"`
+		Failed        bool  `json:"txFailed"`
+		Stealth       bool  `json:"txStealth"`
+		documentExtras
+	}{}
+	body.TimeSeen = time.Now().Unix()
+	body.Hash = tx.Hash().Hex()
+	body.Type = "erc20Transfer"
+	body.FinalParsedData = final
+	body.From = getTxSenderAddress(tx, client)
+	body.To = tx.To().Hex()
+	body.Value = formatEthWeiToEther(tx.Value())
+	body.Nonce = tx.Nonce()
+	formattedGas := new(big.Int).SetUint64(tx.Gas())
+	body.Gas = formatEthWeiToEther(formattedGas)
+	body.GasPrice = formatEthWeiToEther(tx.GasPrice())
+	body.Mined = isTxMined(tx.Hash().Hex(), client)
+	body.Failed = hasTxFailed(tx.Hash().Hex(), client)
+	if isStealth {
+		body.BlockIncluded = getBlockNoByTxHash(tx.Hash().Hex(), client)
+	}
+	body.Stealth = isStealth
+	body.documentExtras = getDocumentExtras(tx, client, isStealth)
+	jsonBytes, _ := json.Marshal(body)
+	jsonBytes = applyRules(tx, client, isStealth, jsonBytes)
+	alertWatchlist(tx, client, isStealth, jsonBytes)
+	// Set up the request object.
+	req := esapi.IndexRequest{
+		Index:   "transactions",
+		Body:    bytes.NewReader(jsonBytes),
+		Refresh: "true",
+	}
+	// Perform the request with the client.
+	res, err := req.Do(context.Background(), es)
+	if err != nil {
+		log.Fatalf("Error getting response: %s", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		log.Printf("[%s] Error indexing document", res.Status())
+	} else {
+		// Deserialize the response into a map.
+		var r map[string]interface{}
+		if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+			log.Printf("Error parsing the response body: %s", err)
+		} else {
+			// Print the response status and indexed document version.
+			log.Printf("[%s] %s; version=%d", res.Status(), r["result"], int(r["_version"].(float64)))
+		}
+	}
+}
+
+func handleERC20IncreaseAllowanceFinal(tx *types.Transaction, client *ethclient.Client, isStealth bool, final erc20Allowance) {
+
+	// Connect to our es client
+	es, err := elasticsearch.NewDefaultClient()
+	if err != nil {
+		log.Fatalf("Error connecting to es client: %s", err)
+	}
+	// Start building a document
+	body := struct {
+		// Time the tx was discovered and other details (for data analysis + Kibana)
+		TimeSeen int64  `json:"timeFirstDiscovered"`
+		Hash     string `json:"txHash"`
+		// Classifcation and other info
+		Type            string         `json:"txType"`
+		FinalParsedData erc20Allowance `json:"finalParsedData"`
+		From            string         `json:"from"`
+		To              string         `json:"to"`
+		Value           float64        `json:"txValue"`
+		Nonce           uint64         `json:"nonce"`
+		GasPrice        float64        `json:"gasPrice"`
+		Gas             float64        `json:"gas"`
+		// Custom tags that are updated after a block including the tx is mined
+		Mined         bool  `json:"txMined"`
+		BlockIncluded int64 `json:"blockIncluded"`
+		Failed        bool  `json:"txFailed"`
+		Stealth       bool  `json:"txStealth"`
+		documentExtras
+	}{}
+	body.TimeSeen = time.Now().Unix()
+	body.Hash = tx.Hash().Hex()
+	body.Type = "erc20Approve"
+	body.FinalParsedData = final
+	body.From = getTxSenderAddress(tx, client)
+	body.To = tx.To().Hex()
+	body.Value = formatEthWeiToEther(tx.Value())
+	body.Nonce = tx.Nonce()
+	formattedGas := new(big.Int).SetUint64(tx.Gas())
+	body.Gas = formatEthWeiToEther(formattedGas)
+	body.GasPrice = formatEthWeiToEther(tx.GasPrice())
+	body.Mined = isTxMined(tx.Hash().Hex(), client)
+	body.Failed = hasTxFailed(tx.Hash().Hex(), client)
+	if isStealth {
+		body.BlockIncluded = getBlockNoByTxHash(tx.Hash().Hex(), client)
+	}
+	body.Stealth = isStealth
+	body.documentExtras = getDocumentExtras(tx, client, isStealth)
+	jsonBytes, _ := json.Marshal(body)
+	jsonBytes = applyRules(tx, client, isStealth, jsonBytes)
+	alertWatchlist(tx, client, isStealth, jsonBytes)
+	// Set up the request object.
+	req := esapi.IndexRequest{
+		Index:   "transactions",
+		Body:    bytes.NewReader(jsonBytes),
+		Refresh: "true",
+	}
+	// Perform the request with the client.
+	res, err := req.Do(context.Background(), es)
+	if err != nil {
+		log.Fatalf("Error getting response: %s", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		log.Printf("[%s] Error indexing document", res.Status())
+	} else {
+		// Deserialize the response into a map.
+		var r map[string]interface{}
+		if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+			log.Printf("Error parsing the response body: %s", err)
+		} else {
+			// Print the response status and indexed document version.
+			log.Printf("[%s] %s; version=%d", res.Status(), r["result"], int(r["_version"].(float64)))
+		}
+	}
+}
+
+func handleWETHWrap(tx *types.Transaction, client *ethclient.Client, isStealth bool, final wethWrap) {
+
+	// Connect to our es client
+	es, err := elasticsearch.NewDefaultClient()
+	if err != nil {
+		log.Fatalf("Error connecting to es client: %s", err)
+	}
+	// Start building a document
+	body := struct {
+		// Time the tx was discovered and other details (for data analysis + Kibana)
+		TimeSeen int64  `json:"timeFirstDiscovered"`
+		Hash     string `json:"txHash"`
+		// Classifcation and other info
+		Type            string   `json:"txType"`
+		FinalParsedData wethWrap `json:"finalParsedData"`
+		From            string   `json:"from"`
+		To              string   `json:"to"`
+		Value           float64  `json:"txValue"`
+		Nonce           uint64   `json:"nonce"`
+		GasPrice        float64  `json:"gasPrice"`
+		Gas             float64  `json:"gas"`
+		// Custom tags that are updated after a block including the tx is mined
+		Mined         bool  `json:"txMined"`
+		BlockIncluded int64 `json:"blockIncluded"`
+		Failed        bool  `json:"txFailed"`
+		Stealth       bool  `json:"txStealth"`
+		documentExtras
+	}{}
+	body.TimeSeen = time.Now().Unix()
+	body.Hash = tx.Hash().Hex()
+	body.Type = "wethWrap"
+	body.FinalParsedData = final
+	body.From = getTxSenderAddress(tx, client)
+	body.To = tx.To().Hex()
+	body.Value = formatEthWeiToEther(tx.Value())
+	body.Nonce = tx.Nonce()
+	formattedGas := new(big.Int).SetUint64(tx.Gas())
+	body.Gas = formatEthWeiToEther(formattedGas)
+	body.GasPrice = formatEthWeiToEther(tx.GasPrice())
+	body.Mined = isTxMined(tx.Hash().Hex(), client)
+	body.Failed = hasTxFailed(tx.Hash().Hex(), client)
+	if isStealth {
+		body.BlockIncluded = getBlockNoByTxHash(tx.Hash().Hex(), client)
+	}
+	body.Stealth = isStealth
+	body.documentExtras = getDocumentExtras(tx, client, isStealth)
+	jsonBytes, _ := json.Marshal(body)
+	jsonBytes = applyRules(tx, client, isStealth, jsonBytes)
+	alertWatchlist(tx, client, isStealth, jsonBytes)
+	// Set up the request object.
+	req := esapi.IndexRequest{
+		Index:   "transactions",
+		Body:    bytes.NewReader(jsonBytes),
+		Refresh: "true",
+	}
+	// Perform the request with the client.
+	res, err := req.Do(context.Background(), es)
+	if err != nil {
+		log.Fatalf("Error getting response: %s", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		log.Printf("[%s] Error indexing document", res.Status())
+	} else {
+		// Deserialize the response into a map.
+		var r map[string]interface{}
+		if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+			log.Printf("Error parsing the response body: %s", err)
+		} else {
+			// Print the response status and indexed document version.
+			log.Printf("[%s] %s; version=%d", res.Status(), r["result"], int(r["_version"].(float64)))
+		}
+	}
+}
+
+func handleNFTTransfer(tx *types.Transaction, client *ethclient.Client, isStealth bool, final tokenTransfer) {
+
+	// Connect to our es client
+	es, err := elasticsearch.NewDefaultClient()
+	if err != nil {
+		log.Fatalf("Error connecting to es client: %s", err)
+	}
+	// Start building a document
+	body := struct {
+		// Time the tx was discovered and other details (for data analysis + Kibana)
+		TimeSeen int64  `json:"timeFirstDiscovered"`
+		Hash     string `json:"txHash"`
+		// Classifcation and other info
+		Type            string        `json:"txType"`
+		FinalParsedData tokenTransfer `json:"finalParsedData"`
+		From            string        `json:"from"`
+		To              string        `json:"to"`
+		Value           float64       `json:"txValue"`
+		Nonce           uint64        `json:"nonce"`
+		GasPrice        float64       `json:"gasPrice"`
+		Gas             float64       `json:"gas"`
+		// Custom tags that are updated after a block including the tx is mined
+		Mined         bool  `json:"txMined"`
+		BlockIncluded int64 `json:"blockIncluded"`
+		Failed        bool  `json:"txFailed"`
+		Stealth       bool  `json:"txStealth"`
+		documentExtras
+	}{}
+	body.TimeSeen = time.Now().Unix()
+	body.Hash = tx.Hash().Hex()
+	body.Type = "nftTransfer"
+	body.FinalParsedData = final
+	body.From = getTxSenderAddress(tx, client)
+	body.To = tx.To().Hex()
+	body.Value = formatEthWeiToEther(tx.Value())
+	body.Nonce = tx.Nonce()
+	formattedGas := new(big.Int).SetUint64(tx.Gas())
+	body.Gas = formatEthWeiToEther(formattedGas)
+	body.GasPrice = formatEthWeiToEther(tx.GasPrice())
+	body.Mined = isTxMined(tx.Hash().Hex(), client)
+	body.Failed = hasTxFailed(tx.Hash().Hex(), client)
+	if isStealth {
+		body.BlockIncluded = getBlockNoByTxHash(tx.Hash().Hex(), client)
+	}
+	body.Stealth = isStealth
+	body.documentExtras = getDocumentExtras(tx, client, isStealth)
+	jsonBytes, _ := json.Marshal(body)
+	jsonBytes = applyRules(tx, client, isStealth, jsonBytes)
+	alertWatchlist(tx, client, isStealth, jsonBytes)
+	// Set up the request object.
+	req := esapi.IndexRequest{
+		Index:   "transactions",
+		Body:    bytes.NewReader(jsonBytes),
+		Refresh: "true",
+	}
+	// Perform the request with the client.
+	res, err := req.Do(context.Background(), es)
+	if err != nil {
+		log.Fatalf("Error getting response: %s", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		log.Printf("[%s] Error indexing document", res.Status())
+	} else {
+		// Deserialize the response into a map.
+		var r map[string]interface{}
+		if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+			log.Printf("Error parsing the response body: %s", err)
+		} else {
+			// Print the response status and indexed document version.
+			log.Printf("[%s] %s; version=%d", res.Status(), r["result"], int(r["_version"].(float64)))
+		}
+	}
+}
+
+func handleContractCallFinal(tx *types.Transaction, client *ethclient.Client, isStealth bool, final contractCall) {
+
+	// Connect to our es client
+	es, err := elasticsearch.NewDefaultClient()
+	if err != nil {
+		log.Fatalf("Error connecting to es client: %s", err)
+	}
+	// Start building a document
+	body := struct {
+		// Time the tx was discovered and other details (for data analysis + Kibana)
+		TimeSeen int64  `json:"timeFirstDiscovered"`
+		Hash     string `json:"txHash"`
+		// Classifcation and other info
+		Type            string       `json:"txType"`
+		FinalParsedData contractCall `json:"finalParsedData"`
+		From            string       `json:"from"`
+		To              string       `json:"to"`
+		Value           float64      `json:"txValue"`
+		Nonce           uint64       `json:"nonce"`
+		GasPrice        float64      `json:"gasPrice"`
+		Gas             float64      `json:"gas"`
+		// Custom tags that are updated after a block including the tx is mined
+		Mined         bool  `json:"txMined"`
+		BlockIncluded int64 `json:"blockIncluded"`
+		Failed        bool  `json:"txFailed"`
+		Stealth       bool  `json:"txStealth"`
+		documentExtras
+	}{}
+	body.TimeSeen = time.Now().Unix()
+	body.Hash = tx.Hash().Hex()
+	body.Type = "contractCall"
+	body.FinalParsedData = final
+	body.From = getTxSenderAddress(tx, client)
+	body.To = tx.To().Hex()
+	body.Value = formatEthWeiToEther(tx.Value())
+	body.Nonce = tx.Nonce()
+	formattedGas := new(big.Int).SetUint64(tx.Gas())
+	body.Gas = formatEthWeiToEther(formattedGas)
+	body.GasPrice = formatEthWeiToEther(tx.GasPrice())
+	body.Mined = isTxMined(tx.Hash().Hex(), client)
+	body.Failed = hasTxFailed(tx.Hash().Hex(), client)
+	if isStealth {
+		body.BlockIncluded = getBlockNoByTxHash(tx.Hash().Hex(), client)
+	}
+	body.Stealth = isStealth
+	body.documentExtras = getDocumentExtras(tx, client, isStealth)
+	jsonBytes, _ := json.Marshal(body)
+	jsonBytes = applyRules(tx, client, isStealth, jsonBytes)
+	alertWatchlist(tx, client, isStealth, jsonBytes)
+	// Set up the request object.
+	req := esapi.IndexRequest{
+		Index:   "transactions",
+		Body:    bytes.NewReader(jsonBytes),
+		Refresh: "true",
+	}
+	// Perform the request with the client.
+	res, err := req.Do(context.Background(), es)
+	if err != nil {
+		log.Fatalf("Error getting response: %s", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		log.Printf("[%s] Error indexing document", res.Status())
+	} else {
+		// Deserialize the response into a map.
+		var r map[string]interface{}
+		if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+			log.Printf("Error parsing the response body: %s", err)
+		} else {
+			// Print the response status and indexed document version.
+			log.Printf("[%s] %s; version=%d", res.Status(), r["result"], int(r["_version"].(float64)))
+		}
+	}
+}
+
+func handleProxyUpgradeFinal(tx *types.Transaction, client *ethclient.Client, isStealth bool, final proxyUpgrade) {
+
+	// Connect to our es client
+	es, err := elasticsearch.NewDefaultClient()
+	if err != nil {
+		log.Fatalf("Error connecting to es client: %s", err)
+	}
+	// Start building a document
+	body := struct {
+		// Time the tx was discovered and other details (for data analysis + Kibana)
+		TimeSeen int64  `json:"timeFirstDiscovered"`
+		Hash     string `json:"txHash"`
+		// Classifcation and other info
+		Type            string       `json:"txType"`
+		FinalParsedData proxyUpgrade `json:"finalParsedData"`
+		From            string       `json:"from"`
+		To              string       `json:"to"`
+		Value           float64      `json:"txValue"`
+		Nonce           uint64       `json:"nonce"`
+		GasPrice        float64      `json:"gasPrice"`
+		Gas             float64      `json:"gas"`
+		// Custom tags that are updated after a block including the tx is mined
+		Mined         bool  `json:"txMined"`
+		BlockIncluded int64 `json:"blockIncluded"`
+		Failed        bool  `json:"txFailed"`
+		Stealth       bool  `json:"txStealth"`
+		documentExtras
+	}{}
+	body.TimeSeen = time.Now().Unix()
+	body.Hash = tx.Hash().Hex()
+	body.Type = "proxyUpgrade"
+	body.FinalParsedData = final
 	body.From = getTxSenderAddress(tx, client)
 	body.To = tx.To().Hex()
 	body.Value = formatEthWeiToEther(tx.Value())
@@ -948,7 +1884,10 @@ func handleEdgeTx(tx *types.Transaction, client *ethclient.Client, isStealth boo
 		body.BlockIncluded = getBlockNoByTxHash(tx.Hash().Hex(), client)
 	}
 	body.Stealth = isStealth
+	body.documentExtras = getDocumentExtras(tx, client, isStealth)
 	jsonBytes, _ := json.Marshal(body)
+	jsonBytes = applyRules(tx, client, isStealth, jsonBytes)
+	alertWatchlist(tx, client, isStealth, jsonBytes)
 	// Set up the request object.
 	req := esapi.IndexRequest{
 		Index:   "transactions",
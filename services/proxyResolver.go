@@ -0,0 +1,222 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	. "github.com/logrusorgru/aurora"
+)
+
+// Upgradeable proxy resolution, the implementation is read straight from the standard storage slots:
+// EIP-1967 (implementation + beacon), EIP-1822 (UUPS "PROXIABLE") and the OpenZeppelin/zeppelinos legacy slot
+// Slots are read through the simulator's fork cache (evmSimulator.go), so every proxy costs at most a few reads per block
+// Resolved implementations are handed to the ABI registry (abiRegistry.go), calls to a proxy are decoded with its implementation's ABI
+// `upgradeTo` style calls are classified on their own as high priority `proxyUpgrade` events, swapping the code behind a proxy is the one thing you want to hear about before it's mined
+
+const (
+	proxyStandardEIP1967 = "eip1967"
+	proxyStandardBeacon  = "eip1967Beacon"
+	proxyStandardEIP1822 = "eip1822"
+	proxyStandardOZ      = "ozLegacy"
+)
+
+var (
+	eip1967ImplementationSlot  = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc") // keccak256("eip1967.proxy.implementation") - 1
+	eip1967BeaconSlot          = common.HexToHash("0xa3f0ad74e5423aebfd80d3ef4346578335a9a72aeaee59ff6cb3582b35133d50") // keccak256("eip1967.proxy.beacon") - 1
+	eip1967AdminSlot           = common.HexToHash("0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103") // keccak256("eip1967.proxy.admin") - 1
+	eip1822ProxiableSlot       = common.HexToHash("0xc5f16f0fcc639fa48a6947836d9850f504798523bf8c9a3a87d5876cf622bcf7") // keccak256("PROXIABLE")
+	ozLegacyImplementationSlot = common.HexToHash("0x7050c9e0f4ca769c69bd3a8ef740bc37934f8e2c036e5a723fd8ee048ed3f8c3") // keccak256("org.zeppelinos.proxy.implementation")
+	ozLegacyAdminSlot          = common.HexToHash("0x10d6a54a4754c8869d6886b5f5d7fbfa5b4522237ea5c60d11bc4e7a1ff9390b") // keccak256("org.zeppelinos.proxy.admin")
+)
+
+var beaconImplementation = []byte{0x5c, 0x60, 0xda, 0x1b} // implementation()
+
+var proxyUpgradeTo = []byte{0x36, 0x59, 0xcf, 0xe6}           // upgradeTo(address), UUPS/transparent proxies and beacons
+var proxyUpgradeToAndCall = []byte{0x4f, 0x1e, 0xf2, 0x86}    // upgradeToAndCall(address,bytes)
+var proxyAdminUpgrade = []byte{0x99, 0xa8, 0x8e, 0xc4}        // ProxyAdmin.upgrade(address,address)
+var proxyAdminUpgradeAndCall = []byte{0x96, 0x23, 0x60, 0x9d} // ProxyAdmin.upgradeAndCall(address,address,bytes)
+var proxyChangeAdmin = []byte{0x8f, 0x28, 0x39, 0x70}         // changeAdmin(address)
+
+type proxyResolution struct {
+	Standard       string
+	Implementation common.Address
+	Beacon         common.Address // Beacon proxies only
+	Admin          common.Address // Transparent proxies only, zero otherwise
+}
+
+// Resolutions for the current head, dropped when the fork cache moves to a new block
+var proxyResolutionCache = struct {
+	lock        sync.Mutex
+	blockHash   common.Hash
+	resolutions map[common.Address]*proxyResolution
+}{
+	resolutions: make(map[common.Address]*proxyResolution),
+}
+
+type proxyUpgrade struct {
+	Method                string `json:"method"` // "upgradeTo", "upgradeToAndCall", "upgrade", "upgradeAndCall" or "changeAdmin"
+	Proxy                 string `json:"proxy"`
+	ProxyStandard         string `json:"proxyStandard"` // Empty when the target doesn't use any of the standard slots
+	ProxyAdmin            string `json:"proxyAdmin"`    // The ProxyAdmin contract the upgrade went through, if any
+	CurrentImplementation string `json:"currentImplementation"`
+	CurrentAdmin          string `json:"currentAdmin"`      // Admin slot of transparent proxies, lets you tell an upgrade that will go through from one that will revert
+	NewImplementation     string `json:"newImplementation"` // Empty for changeAdmin
+	NewAdmin              string `json:"newAdmin"`          // changeAdmin only
+	InitCall              string `json:"initCall"`          // Method the new implementation gets called with (*AndCall variants)
+	Priority              string `json:"priority"`
+}
+
+func readAddressSlot(cache *forkCache, address common.Address, slot common.Hash, client *ethclient.Client) common.Address {
+	value, err := cache.getStorage(address, slot, client)
+	if err != nil {
+		return common.Address{}
+	}
+	return common.BytesToAddress(value.Bytes())
+}
+
+func resolveBeaconImplementation(beacon common.Address, cache *forkCache, client *ethclient.Client) common.Address {
+	result, err := client.CallContract(context.Background(), ethereum.CallMsg{To: &beacon, Data: beaconImplementation}, cache.blockNumber)
+	if err != nil || len(result) < 32 {
+		return common.Address{}
+	}
+	return common.BytesToAddress(result[:32])
+}
+
+// Read the implementation slots of `address` at the latest head, false if it isn't a (standard) proxy
+func resolveProxy(address common.Address, client *ethclient.Client) (*proxyResolution, bool) {
+	cache, head, err := getSimulatorCache(client)
+	if err != nil {
+		return nil, false
+	}
+	proxyResolutionCache.lock.Lock()
+	newBlock := proxyResolutionCache.blockHash != head.Hash()
+	if newBlock {
+		proxyResolutionCache.blockHash = head.Hash()
+		proxyResolutionCache.resolutions = make(map[common.Address]*proxyResolution)
+	}
+	resolution, ok := proxyResolutionCache.resolutions[address]
+	proxyResolutionCache.lock.Unlock()
+	if newBlock {
+		// Upgrades land in blocks, implementations are looked up again from the new head
+		clearProxyImplementations()
+	}
+	if ok {
+		return resolution, resolution != nil
+	}
+	resolution = nil
+	if implementation := readAddressSlot(cache, address, eip1967ImplementationSlot, client); implementation != (common.Address{}) {
+		resolution = &proxyResolution{Standard: proxyStandardEIP1967, Implementation: implementation, Admin: readAddressSlot(cache, address, eip1967AdminSlot, client)}
+	} else if beacon := readAddressSlot(cache, address, eip1967BeaconSlot, client); beacon != (common.Address{}) {
+		if implementation := resolveBeaconImplementation(beacon, cache, client); implementation != (common.Address{}) {
+			resolution = &proxyResolution{Standard: proxyStandardBeacon, Implementation: implementation, Beacon: beacon}
+		}
+	} else if implementation := readAddressSlot(cache, address, eip1822ProxiableSlot, client); implementation != (common.Address{}) {
+		resolution = &proxyResolution{Standard: proxyStandardEIP1822, Implementation: implementation}
+	} else if implementation := readAddressSlot(cache, address, ozLegacyImplementationSlot, client); implementation != (common.Address{}) {
+		resolution = &proxyResolution{Standard: proxyStandardOZ, Implementation: implementation, Admin: readAddressSlot(cache, address, ozLegacyAdminSlot, client)}
+	}
+	if resolution != nil {
+		registerProxyImplementation(address, resolution.Implementation)
+	}
+	proxyResolutionCache.lock.Lock()
+	proxyResolutionCache.resolutions[address] = resolution
+	proxyResolutionCache.lock.Unlock()
+	return resolution, resolution != nil
+}
+
+// Implementation behind a proxy, as a hex string for documents (empty if it isn't one)
+func getProxyImplementation(address common.Address, client *ethclient.Client) string {
+	if resolution, ok := resolveProxy(address, client); ok {
+		return resolution.Implementation.Hex()
+	}
+	return ""
+}
+
+func isProxyUpgrade(tx *types.Transaction) bool {
+	selector := tx.Data()[:4]
+	return bytes.Equal(selector, proxyUpgradeTo) || bytes.Equal(selector, proxyUpgradeToAndCall) ||
+		bytes.Equal(selector, proxyAdminUpgrade) || bytes.Equal(selector, proxyAdminUpgradeAndCall) || bytes.Equal(selector, proxyChangeAdmin)
+}
+
+// The method the new implementation is initialised with, best effort through the ABI registry and the 4byte database
+func describeInitCall(implementation common.Address, data []byte) string {
+	if len(data) < 4 {
+		return ""
+	}
+	if _, _, contractAbi, ok := lookupContractABI(implementation); ok {
+		if method, err := contractAbi.MethodById(data[:4]); err == nil {
+			return method.Sig
+		}
+	}
+	if calls := decodeCallData(data); len(calls) > 0 {
+		return calls[0].Signature
+	}
+	return fmt.Sprintf("0x%x", data[:4])
+}
+
+func handleProxyUpgrade(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+	selector := tx.Data()[:4]
+	var method string
+	var args abi.Arguments
+	var err error
+	switch {
+	case bytes.Equal(selector, proxyUpgradeTo):
+		method, args, err = parseFnSignature("upgradeTo(address)")
+	case bytes.Equal(selector, proxyUpgradeToAndCall):
+		method, args, err = parseFnSignature("upgradeToAndCall(address,bytes)")
+	case bytes.Equal(selector, proxyAdminUpgrade):
+		method, args, err = parseFnSignature("upgrade(address,address)")
+	case bytes.Equal(selector, proxyAdminUpgradeAndCall):
+		method, args, err = parseFnSignature("upgradeAndCall(address,address,bytes)")
+	default:
+		method, args, err = parseFnSignature("changeAdmin(address)")
+	}
+	var values []interface{}
+	if err == nil {
+		values, err = args.UnpackValues(tx.Data()[4:])
+	}
+	if err != nil {
+		fmt.Println("Error decoding proxy upgrade:", tx.Hash().Hex(), err)
+		if fullMode {
+			handleMiscTx(tx, client, isStealth)
+		}
+		return
+	}
+	final := proxyUpgrade{Method: method, Proxy: tx.To().Hex(), Priority: "high"}
+	// ProxyAdmin calls name the proxy as their first argument
+	if strings.HasPrefix(method, "upgradeAnd") || method == "upgrade" {
+		final.ProxyAdmin = tx.To().Hex()
+		final.Proxy = values[0].(common.Address).Hex()
+		values = values[1:]
+	}
+	if method == "changeAdmin" {
+		final.NewAdmin = values[0].(common.Address).Hex()
+	} else {
+		newImplementation := values[0].(common.Address)
+		final.NewImplementation = newImplementation.Hex()
+		if len(values) > 1 {
+			final.InitCall = describeInitCall(newImplementation, values[1].([]byte))
+		}
+	}
+	if resolution, ok := resolveProxy(common.HexToAddress(final.Proxy), client); ok {
+		final.ProxyStandard = resolution.Standard
+		final.CurrentImplementation = resolution.Implementation.Hex()
+		if resolution.Admin != (common.Address{}) {
+			final.CurrentAdmin = resolution.Admin.Hex()
+		}
+	}
+	fmt.Println()
+	fmt.Println(BgRed("New TX: Proxy Upgrade (" + final.Method + ")"))
+	fmt.Println("Hash: ", tx.Hash().Hex(), " Proxy: ", final.Proxy, " Current: ", final.CurrentImplementation, " New: ", final.NewImplementation+final.NewAdmin)
+	if fullMode {
+		handleProxyUpgradeFinal(tx, client, isStealth, final)
+	}
+}
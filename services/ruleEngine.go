@@ -0,0 +1,853 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	. "github.com/logrusorgru/aurora"
+)
+
+// Declarative rules over classified documents, signals can be added without a rebuild
+// Rules live in ./data/rules.json (override with RULES_PATH in .env) and are re-read within a few seconds of a change:
+// [{"name": "whaleWethTrades", "when": "txType == \"uniswapTrade\" && amountInUSD > 100000 && path contains \"WETH\"",
+//   "window": {"duration": "10m", "groupBy": "from", "having": "count >= 3"}, "cooldown": "5m",
+//   "actions": [{"type": "log"}, {"type": "tag", "tag": "whale"}, {"type": "webhook", "url": "https://..."}, {"type": "strategy", "command": "node panther/src/index.js"}]}]
+// Conditions compare document fields with && || ! and parentheses, operators are == != > >= < <= and contains
+// Fields are looked up in the document and then in finalParsedData, dotted paths go into nested objects and arrays (one matching element is enough)
+// Strings are quoted, bare words are always fields: a field the document doesn't have never matches, so a typo can't turn into a string literal
+// Addresses are equal to their label and, for the tokens we price (tokenPrices.go), their symbol, so `path contains "WETH"` works on a path of addresses
+// Windowed rules only fire once `having` holds over the matches of the last `duration` (per groupBy value), with count, sum(f), avg(f), min(f), max(f) and distinct(f)
+// Rules run right before a document is indexed (full mode) or on the tx basics right after txClassifier (quick mode)
+
+const defaultRulesPath = "./data/rules.json"
+
+// How often the file is checked for changes
+const rulesReloadInterval = 10
+
+// Strategies are external commands, they get the match on stdin and are killed after this long
+const ruleStrategyTimeout = 30 * time.Second
+
+const (
+	ruleActionLog      = "log"
+	ruleActionWebhook  = "webhook"
+	ruleActionTag      = "tag"
+	ruleActionStrategy = "strategy"
+)
+
+type ruleAction struct {
+	Type    string `json:"type"`
+	URL     string `json:"url,omitempty"`     // webhook
+	Tag     string `json:"tag,omitempty"`     // tag, defaults to the rule name
+	Command string `json:"command,omitempty"` // strategy, run with `sh -c`
+}
+
+type ruleWindow struct {
+	Duration string `json:"duration"` // "30s", "10m", "1h"
+	GroupBy  string `json:"groupBy,omitempty"`
+	Having   string `json:"having"`
+}
+
+type ruleEvent struct {
+	Time   time.Time
+	Values map[string]interface{} // Values of the fields the aggregates need
+}
+
+type ruleAggregate struct {
+	Name  string // As written in `having`, "sum(amountInUSD)"
+	Fn    string
+	Field string
+}
+
+type rule struct {
+	Name     string       `json:"name"`
+	When     string       `json:"when"`
+	Window   *ruleWindow  `json:"window,omitempty"`
+	Cooldown string       `json:"cooldown,omitempty"` // Minimum time between two firings (per groupBy value)
+	Actions  []ruleAction `json:"actions"`
+	// Compiled
+	when       *ruleNode
+	having     *ruleNode
+	duration   time.Duration
+	cooldown   time.Duration
+	aggregates []ruleAggregate
+	// Window state, kept across reloads as long as the rule doesn't change
+	events  map[string][]ruleEvent
+	firedAt map[string]time.Time
+}
+
+// What actions get (logged, POSTed, piped into strategies)
+type ruleMatch struct {
+	Rule       string             `json:"rule"`
+	Time       int64              `json:"time"`
+	TxHash     string             `json:"txHash"`
+	Group      string             `json:"group,omitempty"`
+	Aggregates map[string]float64 `json:"aggregates,omitempty"`
+	Document   json.RawMessage    `json:"document"`
+}
+
+var ruleEngine = struct {
+	lock  sync.Mutex
+	rules []*rule
+	// Size + mod time of the file the rules were read from
+	fingerprint string
+	checkedAt   int64
+}{}
+
+func getRulesPath() string {
+	if path := os.Getenv("RULES_PATH"); path != "" {
+		return path
+	}
+	return defaultRulesPath
+}
+
+// Expressions
+
+const (
+	ruleTokenIdent = iota
+	ruleTokenNumber
+	ruleTokenString
+	ruleTokenOp
+	ruleTokenLiteral // true, false, null
+)
+
+type ruleToken struct {
+	Kind int
+	Text string
+}
+
+type ruleNode struct {
+	Op          string // "&&", "||", "!", a comparison, "field" or "literal"
+	Left, Right *ruleNode
+	Name        string      // field
+	Value       interface{} // literal
+}
+
+var ruleNumberRegex = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?([eE][+-]?[0-9]+)?`)
+var ruleIdentRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*(\([A-Za-z0-9_.]*\))?`)
+var ruleHexRegex = regexp.MustCompile(`^0x[0-9a-fA-F]*`)
+
+var ruleComparisons = map[string]bool{"==": true, "!=": true, ">": true, ">=": true, "<": true, "<=": true, "contains": true}
+
+func tokenizeRuleExpr(expr string) ([]ruleToken, error) {
+	var tokens []ruleToken
+	for i := 0; i < len(expr); {
+		rest := expr[i:]
+		switch {
+		case rest[0] == ' ' || rest[0] == '\t' || rest[0] == '\n':
+			i++
+		case rest[0] == '(' || rest[0] == ')':
+			tokens = append(tokens, ruleToken{ruleTokenOp, rest[:1]})
+			i++
+		case rest[0] == '"' || rest[0] == '\'':
+			end := strings.IndexByte(rest[1:], rest[0])
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated string at %d", i)
+			}
+			tokens = append(tokens, ruleToken{ruleTokenString, rest[1 : end+1]})
+			i += end + 2
+		case strings.HasPrefix(rest, "&&") || strings.HasPrefix(rest, "||") || strings.HasPrefix(rest, "==") ||
+			strings.HasPrefix(rest, "!=") || strings.HasPrefix(rest, ">=") || strings.HasPrefix(rest, "<="):
+			tokens = append(tokens, ruleToken{ruleTokenOp, rest[:2]})
+			i += 2
+		case rest[0] == '>' || rest[0] == '<' || rest[0] == '!':
+			tokens = append(tokens, ruleToken{ruleTokenOp, rest[:1]})
+			i++
+		case ruleHexRegex.MatchString(rest) && len(ruleHexRegex.FindString(rest)) > 2:
+			// Addresses and hashes are compared as strings
+			hex := ruleHexRegex.FindString(rest)
+			tokens = append(tokens, ruleToken{ruleTokenString, hex})
+			i += len(hex)
+		case ruleNumberRegex.MatchString(rest):
+			number := ruleNumberRegex.FindString(rest)
+			tokens = append(tokens, ruleToken{ruleTokenNumber, number})
+			i += len(number)
+		case ruleIdentRegex.MatchString(rest):
+			ident := ruleIdentRegex.FindString(rest)
+			switch ident {
+			case "contains":
+				tokens = append(tokens, ruleToken{ruleTokenOp, ident})
+			case "true", "false", "null":
+				tokens = append(tokens, ruleToken{ruleTokenLiteral, ident})
+			default:
+				tokens = append(tokens, ruleToken{ruleTokenIdent, ident})
+			}
+			i += len(ident)
+		default:
+			return nil, fmt.Errorf("unexpected %q at %d", rest[0], i)
+		}
+	}
+	return tokens, nil
+}
+
+type ruleParser struct {
+	tokens []ruleToken
+	pos    int
+}
+
+func (p *ruleParser) peek() (ruleToken, bool) {
+	if p.pos >= len(p.tokens) {
+		return ruleToken{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *ruleParser) acceptOp(ops ...string) (string, bool) {
+	token, ok := p.peek()
+	if !ok || token.Kind != ruleTokenOp {
+		return "", false
+	}
+	for _, op := range ops {
+		if token.Text == op {
+			p.pos++
+			return op, true
+		}
+	}
+	return "", false
+}
+
+func (p *ruleParser) parseOr() (*ruleNode, error) {
+	left, err := p.parseAnd()
+	for err == nil {
+		if _, ok := p.acceptOp("||"); !ok {
+			break
+		}
+		var right *ruleNode
+		right, err = p.parseAnd()
+		left = &ruleNode{Op: "||", Left: left, Right: right}
+	}
+	return left, err
+}
+
+func (p *ruleParser) parseAnd() (*ruleNode, error) {
+	left, err := p.parseUnary()
+	for err == nil {
+		if _, ok := p.acceptOp("&&"); !ok {
+			break
+		}
+		var right *ruleNode
+		right, err = p.parseUnary()
+		left = &ruleNode{Op: "&&", Left: left, Right: right}
+	}
+	return left, err
+}
+
+func (p *ruleParser) parseUnary() (*ruleNode, error) {
+	if _, ok := p.acceptOp("!"); ok {
+		operand, err := p.parseUnary()
+		return &ruleNode{Op: "!", Left: operand}, err
+	}
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	token, ok := p.peek()
+	if !ok || token.Kind != ruleTokenOp || !ruleComparisons[token.Text] {
+		return left, nil
+	}
+	p.pos++
+	right, err := p.parseOperand()
+	return &ruleNode{Op: token.Text, Left: left, Right: right}, err
+}
+
+func (p *ruleParser) parseOperand() (*ruleNode, error) {
+	token, ok := p.peek()
+	if !ok {
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	p.pos++
+	switch token.Kind {
+	case ruleTokenIdent:
+		return &ruleNode{Op: "field", Name: token.Text}, nil
+	case ruleTokenString:
+		return &ruleNode{Op: "literal", Value: token.Text}, nil
+	case ruleTokenNumber:
+		value, err := strconv.ParseFloat(token.Text, 64)
+		return &ruleNode{Op: "literal", Value: value}, err
+	case ruleTokenLiteral:
+		literal := map[string]interface{}{"true": true, "false": false, "null": nil}
+		return &ruleNode{Op: "literal", Value: literal[token.Text]}, nil
+	}
+	if token.Text == "(" {
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := p.acceptOp(")"); !ok {
+			return nil, fmt.Errorf("missing )")
+		}
+		return node, nil
+	}
+	return nil, fmt.Errorf("unexpected %q", token.Text)
+}
+
+func parseRuleExpr(expr string) (*ruleNode, error) {
+	tokens, err := tokenizeRuleExpr(expr)
+	if err != nil {
+		return nil, err
+	}
+	parser := &ruleParser{tokens: tokens}
+	node, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if token, ok := parser.peek(); ok {
+		return nil, fmt.Errorf("unexpected %q", token.Text)
+	}
+	return node, nil
+}
+
+// Every field an expression refers to
+func collectRuleFields(node *ruleNode, fields []string) []string {
+	if node == nil {
+		return fields
+	}
+	if node.Op == "field" {
+		return append(fields, node.Name)
+	}
+	return collectRuleFields(node.Right, collectRuleFields(node.Left, fields))
+}
+
+// Evaluation
+
+type ruleDocument struct {
+	fields map[string]interface{}
+}
+
+func lookupRulePath(value interface{}, path []string) (interface{}, bool) {
+	if len(path) == 0 {
+		return value, true
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		child, ok := v[path[0]]
+		if !ok {
+			return nil, false
+		}
+		return lookupRulePath(child, path[1:])
+	case []interface{}:
+		var values []interface{}
+		for _, item := range v {
+			if child, ok := lookupRulePath(item, path); ok {
+				if children, isList := child.([]interface{}); isList {
+					values = append(values, children...)
+				} else {
+					values = append(values, child)
+				}
+			}
+		}
+		return values, len(values) > 0
+	}
+	return nil, false
+}
+
+func (doc *ruleDocument) resolve(name string) (interface{}, bool) {
+	if value, ok := doc.fields[name]; ok {
+		return value, true
+	}
+	if value, ok := lookupRulePath(doc.fields, strings.Split(name, ".")); ok {
+		return value, true
+	}
+	return lookupRulePath(doc.fields["finalParsedData"], strings.Split(name, "."))
+}
+
+func ruleTruthy(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case float64:
+		return v != 0
+	case string:
+		return v != ""
+	case []interface{}:
+		return len(v) > 0
+	case map[string]interface{}:
+		return len(v) > 0
+	}
+	return true
+}
+
+func ruleNumber(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case string:
+		number, err := strconv.ParseFloat(v, 64)
+		return number, err == nil
+	}
+	return 0, false
+}
+
+func isRuleAddress(value interface{}) bool {
+	s, ok := value.(string)
+	return ok && strings.HasPrefix(s, "0x") && common.IsHexAddress(s)
+}
+
+// An address is equal to its label (label store, feed registry) and to its symbol if it's one of the known tokens
+// Self-reported symbols don't count, any token can call itself WETH
+func (doc *ruleDocument) addressHasName(address string, name string) bool {
+	if label, ok := lookupAddressLabel(common.HexToAddress(address)); ok && strings.EqualFold(label.Label, name) {
+		return true
+	}
+	token, ok := knownTokens[common.HexToAddress(address)]
+	return ok && strings.EqualFold(token.Symbol, name)
+}
+
+func (doc *ruleDocument) equal(a interface{}, b interface{}) bool {
+	if x, ok := a.(float64); ok {
+		y, ok := ruleNumber(b)
+		return ok && x == y
+	}
+	if y, ok := b.(float64); ok {
+		x, ok := ruleNumber(a)
+		return ok && x == y
+	}
+	x, xString := a.(string)
+	y, yString := b.(string)
+	if !xString || !yString {
+		return a == b
+	}
+	if strings.EqualFold(x, y) {
+		return true
+	}
+	if isRuleAddress(x) && !isRuleAddress(y) {
+		return doc.addressHasName(x, y)
+	}
+	if isRuleAddress(y) && !isRuleAddress(x) {
+		return doc.addressHasName(y, x)
+	}
+	return false
+}
+
+func (doc *ruleDocument) compare(op string, left interface{}, right interface{}) bool {
+	if op == "!=" {
+		return !doc.compare("==", left, right)
+	}
+	if op == "contains" {
+		switch l := left.(type) {
+		case []interface{}:
+			for _, item := range l {
+				if doc.equal(item, right) {
+					return true
+				}
+			}
+		case string:
+			r, ok := right.(string)
+			return (ok && strings.Contains(strings.ToLower(l), strings.ToLower(r))) || doc.equal(l, right)
+		}
+		return false
+	}
+	// Lists match when any element does
+	if l, ok := left.([]interface{}); ok {
+		for _, item := range l {
+			if doc.compare(op, item, right) {
+				return true
+			}
+		}
+		return false
+	}
+	if r, ok := right.([]interface{}); ok {
+		for _, item := range r {
+			if doc.compare(op, left, item) {
+				return true
+			}
+		}
+		return false
+	}
+	if op == "==" {
+		return doc.equal(left, right)
+	}
+	x, xOk := ruleNumber(left)
+	y, yOk := ruleNumber(right)
+	if !xOk || !yOk {
+		return false
+	}
+	switch op {
+	case ">":
+		return x > y
+	case ">=":
+		return x >= y
+	case "<":
+		return x < y
+	}
+	return x <= y
+}
+
+// Fields the document doesn't have are nil, which only equals null
+func (doc *ruleDocument) operand(node *ruleNode) interface{} {
+	switch node.Op {
+	case "field":
+		value, _ := doc.resolve(node.Name)
+		return value
+	case "literal":
+		return node.Value
+	}
+	return doc.eval(node)
+}
+
+func (doc *ruleDocument) eval(node *ruleNode) bool {
+	switch node.Op {
+	case "&&":
+		return doc.eval(node.Left) && doc.eval(node.Right)
+	case "||":
+		return doc.eval(node.Left) || doc.eval(node.Right)
+	case "!":
+		return !doc.eval(node.Left)
+	case "field":
+		value, ok := doc.resolve(node.Name)
+		return ok && ruleTruthy(value)
+	case "literal":
+		return ruleTruthy(node.Value)
+	}
+	return doc.compare(node.Op, doc.operand(node.Left), doc.operand(node.Right))
+}
+
+// Loading
+
+var ruleAggregateRegex = regexp.MustCompile(`^(sum|avg|min|max|distinct)\(([A-Za-z0-9_.]+)\)$`)
+
+func compileRule(r *rule) error {
+	var err error
+	if r.Name == "" {
+		return fmt.Errorf("rule without a name")
+	}
+	if r.when, err = parseRuleExpr(r.When); err != nil {
+		return fmt.Errorf("when: %v", err)
+	}
+	if r.Cooldown != "" {
+		if r.cooldown, err = time.ParseDuration(r.Cooldown); err != nil {
+			return fmt.Errorf("cooldown: %v", err)
+		}
+	}
+	if r.Window != nil {
+		if r.duration, err = time.ParseDuration(r.Window.Duration); err != nil || r.duration <= 0 {
+			return fmt.Errorf("window duration: %q", r.Window.Duration)
+		}
+		if r.having, err = parseRuleExpr(r.Window.Having); err != nil {
+			return fmt.Errorf("having: %v", err)
+		}
+		seen := make(map[string]bool)
+		for _, name := range collectRuleFields(r.having, nil) {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			if name == "count" {
+				r.aggregates = append(r.aggregates, ruleAggregate{Name: name, Fn: name})
+			} else if match := ruleAggregateRegex.FindStringSubmatch(name); match != nil {
+				r.aggregates = append(r.aggregates, ruleAggregate{Name: name, Fn: match[1], Field: match[2]})
+			} else {
+				return fmt.Errorf("having: unknown aggregate %q", name)
+			}
+		}
+	}
+	for _, action := range r.Actions {
+		switch {
+		case action.Type == ruleActionLog || action.Type == ruleActionTag:
+		case action.Type == ruleActionWebhook && action.URL != "":
+		case action.Type == ruleActionStrategy && action.Command != "":
+		default:
+			return fmt.Errorf("invalid action %q", action.Type)
+		}
+	}
+	r.events = make(map[string][]ruleEvent)
+	r.firedAt = make(map[string]time.Time)
+	return nil
+}
+
+func loadRules() ([]*rule, error) {
+	buf, err := ioutil.ReadFile(getRulesPath())
+	if err != nil {
+		return nil, err
+	}
+	var rules []*rule
+	if err := json.Unmarshal(buf, &rules); err != nil {
+		return nil, err
+	}
+	compiled := []*rule{}
+	for _, r := range rules {
+		if err := compileRule(r); err != nil {
+			fmt.Println("Skipping rule", r.Name+":", err)
+			continue
+		}
+		compiled = append(compiled, r)
+	}
+	return compiled, nil
+}
+
+// Re-read the file if it changed since the last check, must be called with the lock held
+func refreshRules() {
+	now := time.Now().Unix()
+	if ruleEngine.checkedAt+rulesReloadInterval > now {
+		return
+	}
+	ruleEngine.checkedAt = now
+	info, err := os.Stat(getRulesPath())
+	if err != nil {
+		ruleEngine.rules, ruleEngine.fingerprint = nil, ""
+		return
+	}
+	fingerprint := fmt.Sprintf("%d:%d", info.Size(), info.ModTime().UnixNano())
+	if fingerprint == ruleEngine.fingerprint {
+		return
+	}
+	ruleEngine.fingerprint = fingerprint
+	rules, err := loadRules()
+	if err != nil {
+		fmt.Println("Error loading rules:", err)
+		return
+	}
+	// Unchanged rules keep their window
+	previous := make(map[string]*rule)
+	for _, r := range ruleEngine.rules {
+		previous[r.Name] = r
+	}
+	for _, r := range rules {
+		old, ok := previous[r.Name]
+		if ok && old.When == r.When && old.Cooldown == r.Cooldown && (old.Window == nil) == (r.Window == nil) && (r.Window == nil || *old.Window == *r.Window) {
+			r.events, r.firedAt = old.events, old.firedAt
+		}
+	}
+	ruleEngine.rules = rules
+	fmt.Println("Loaded", len(rules), "rules")
+}
+
+func aggregateRuleEvents(events []ruleEvent, aggregates []ruleAggregate) map[string]float64 {
+	results := make(map[string]float64)
+	for _, aggregate := range aggregates {
+		if aggregate.Fn == "count" {
+			results[aggregate.Name] = float64(len(events))
+			continue
+		}
+		var values []interface{}
+		for _, event := range events {
+			if list, ok := event.Values[aggregate.Field].([]interface{}); ok {
+				values = append(values, list...)
+			} else if value, ok := event.Values[aggregate.Field]; ok {
+				values = append(values, value)
+			}
+		}
+		if aggregate.Fn == "distinct" {
+			distinct := make(map[string]bool)
+			for _, value := range values {
+				distinct[strings.ToLower(fmt.Sprint(value))] = true
+			}
+			results[aggregate.Name] = float64(len(distinct))
+			continue
+		}
+		var sum, min, max float64
+		numbers := 0
+		for _, value := range values {
+			number, ok := ruleNumber(value)
+			if !ok {
+				continue
+			}
+			if numbers == 0 || number < min {
+				min = number
+			}
+			if numbers == 0 || number > max {
+				max = number
+			}
+			sum += number
+			numbers++
+		}
+		switch aggregate.Fn {
+		case "sum":
+			results[aggregate.Name] = sum
+		case "min":
+			results[aggregate.Name] = min
+		case "max":
+			results[aggregate.Name] = max
+		case "avg":
+			if numbers > 0 {
+				results[aggregate.Name] = sum / float64(numbers)
+			}
+		}
+	}
+	return results
+}
+
+// Add the match to the rule's window and check `having`, returns the group and aggregates when the rule should fire
+// Must be called with the lock held, like everything else touching the window state
+func (r *rule) updateWindow(doc *ruleDocument, now time.Time) (string, map[string]float64, bool) {
+	group := ""
+	if r.Window.GroupBy != "" {
+		value, _ := doc.resolve(r.Window.GroupBy)
+		group = strings.ToLower(fmt.Sprint(value))
+	}
+	event := ruleEvent{Time: now, Values: make(map[string]interface{})}
+	for _, aggregate := range r.aggregates {
+		if aggregate.Field != "" {
+			event.Values[aggregate.Field], _ = doc.resolve(aggregate.Field)
+		}
+	}
+	r.events[group] = append(r.events[group], event)
+	// Expire old matches, groups that went quiet are dropped
+	for key, events := range r.events {
+		start := 0
+		for start < len(events) && now.Sub(events[start].Time) > r.duration {
+			start++
+		}
+		if start == len(events) {
+			delete(r.events, key)
+		} else {
+			r.events[key] = events[start:]
+		}
+	}
+	aggregates := aggregateRuleEvents(r.events[group], r.aggregates)
+	values := make(map[string]interface{}, len(aggregates))
+	for name, value := range aggregates {
+		values[name] = value
+	}
+	return group, aggregates, (&ruleDocument{fields: values}).eval(r.having)
+}
+
+func runRuleStrategy(command string, payload []byte) {
+	ctx, cancel := context.WithTimeout(context.Background(), ruleStrategyTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, "sh", "-c", command)
+	cmd.Stdin = bytes.NewReader(payload)
+	output, err := cmd.CombinedOutput()
+	if len(output) > 0 {
+		fmt.Print(string(output))
+	}
+	if err != nil {
+		fmt.Println("Strategy", command, "failed:", err)
+	}
+}
+
+// Run the rule's actions, returns the tags to add to the document
+func (r *rule) fire(match ruleMatch) []string {
+	var tags []string
+	payload, _ := json.Marshal(match)
+	for _, action := range r.Actions {
+		switch action.Type {
+		case ruleActionLog:
+			fmt.Println()
+			fmt.Println(Magenta("Rule " + r.Name + " matched: " + match.TxHash))
+			if len(match.Aggregates) > 0 {
+				fmt.Println("Group: ", match.Group, " Aggregates: ", match.Aggregates)
+			}
+		case ruleActionWebhook:
+			go postWebhook(action.URL, payload)
+		case ruleActionTag:
+			tag := action.Tag
+			if tag == "" {
+				tag = r.Name
+			}
+			tags = append(tags, tag)
+		case ruleActionStrategy:
+			go runRuleStrategy(action.Command, payload)
+		}
+	}
+	return tags
+}
+
+// Window and cooldown checks for a rule whose `when` matched, fills in the group and aggregates of the match. Must be called with the lock held
+func (r *rule) shouldFire(doc *ruleDocument, now time.Time, match *ruleMatch) bool {
+	if r.Window != nil {
+		var fire bool
+		if match.Group, match.Aggregates, fire = r.updateWindow(doc, now); !fire {
+			return false
+		}
+	}
+	if r.cooldown > 0 && now.Sub(r.firedAt[match.Group]) < r.cooldown {
+		return false
+	}
+	r.firedAt[match.Group] = now
+	return true
+}
+
+// Evaluate every rule against a classified tx, `document` is what's indexed for it (nil in quick mode)
+// Returns the document with the tags of the rules that fired
+// `when` can hit the label store, so it's evaluated on a snapshot of the rules without the lock, which is only taken for the window state
+func applyRules(tx *types.Transaction, client *ethclient.Client, isStealth bool, document []byte) []byte {
+	ruleEngine.lock.Lock()
+	refreshRules()
+	rules := ruleEngine.rules
+	ruleEngine.lock.Unlock()
+	if len(rules) == 0 {
+		return document
+	}
+	source := document
+	if source == nil {
+		source = getTxSummaryDocument(tx, client, isStealth)
+	}
+	doc := &ruleDocument{}
+	if err := json.Unmarshal(source, &doc.fields); err != nil {
+		return document
+	}
+	now := time.Now()
+	var tags []string
+	for _, r := range rules {
+		if !doc.eval(r.when) {
+			continue
+		}
+		match := ruleMatch{Rule: r.Name, Time: now.Unix(), TxHash: tx.Hash().Hex(), Document: source}
+		ruleEngine.lock.Lock()
+		fire := r.shouldFire(doc, now, &match)
+		ruleEngine.lock.Unlock()
+		if fire {
+			tags = append(tags, r.fire(match)...)
+		}
+	}
+	if len(tags) == 0 || document == nil {
+		return document
+	}
+	existing, _ := doc.fields["tags"].([]interface{})
+	for _, tag := range tags {
+		existing = append(existing, tag)
+	}
+	doc.fields["tags"] = existing
+	tagged, err := json.Marshal(doc.fields)
+	if err != nil {
+		return document
+	}
+	return tagged
+}
+
+// Parse the rules file and report what's wrong with it (`./helios -checkRules`)
+// The fields each rule reads are listed too, a misspelled one shows up there instead of silently never matching
+func CheckRules() {
+	rules, err := loadRules()
+	if err != nil {
+		fmt.Println("Error loading rules:", err)
+		return
+	}
+	for _, r := range rules {
+		window := ""
+		if r.Window != nil {
+			window = " over " + r.Window.Duration + " having " + r.Window.Having
+		}
+		fields := collectRuleFields(r.when, nil)
+		if r.Window != nil {
+			if r.Window.GroupBy != "" {
+				fields = append(fields, r.Window.GroupBy)
+			}
+			for _, aggregate := range r.aggregates {
+				if aggregate.Field != "" {
+					fields = append(fields, aggregate.Field)
+				}
+			}
+		}
+		unique := []string{}
+		for _, field := range fields {
+			unique, _ = appendUnique(unique, field, 0)
+		}
+		fmt.Println(Cyan(r.Name), r.When+window, len(r.Actions), "actions, fields:", strings.Join(unique, ", "))
+	}
+}
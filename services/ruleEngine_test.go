@@ -0,0 +1,179 @@
+package services
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+const ruleTestDocument = `{
+	"txType": "uniswapTrade",
+	"from": "0xSender",
+	"txValue": 1.5,
+	"txStealth": false,
+	"tags": ["emitsSwap", "botCluster"],
+	"path": ["0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2", "0x000000000000000000000000000000000000dEaD"],
+	"innerCalls": [{"method": "swap", "depth": 1}, {"method": "transfer", "depth": 2}],
+	"finalParsedData": {"venue": "Uniswap V2", "amountIn": 250, "amountInUSD": 120000, "outputTokenSymbol": "DAI"}
+}`
+
+func newRuleTestDocument(t *testing.T) *ruleDocument {
+	doc := &ruleDocument{}
+	if err := json.Unmarshal([]byte(ruleTestDocument), &doc.fields); err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+func TestRuleExprEval(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		want bool
+	}{
+		// Precedence
+		{"and binds tighter than or", `txType == "poolTrade" && txValue > 100 || txValue > 1`, true},
+		{"and binds tighter than or, other side", `txValue > 1 || txType == "poolTrade" && txValue > 100`, true},
+		{"parentheses", `(txType == "poolTrade" || txValue > 1) && txValue > 100`, false},
+		{"not binds tighter than and", `!txStealth && txValue > 1`, true},
+		{"not on a group", `!(txStealth || txValue > 1)`, false},
+		{"comparison binds tighter than not", `!txValue > 100`, true},
+		// Comparisons and literals
+		{"string equality is case insensitive", `txType == "UniswapTrade"`, true},
+		{"single quotes", `txType == 'uniswapTrade'`, true},
+		{"not equal", `txType != "poolTrade"`, true},
+		{"numbers", `txValue >= 1.5 && txValue <= 1.5 && txValue < 2`, true},
+		{"booleans", `txStealth == false`, true},
+		{"finalParsedData fallback", `amountInUSD > 100000 && venue == "Uniswap V2"`, true},
+		{"dotted path", `finalParsedData.outputTokenSymbol == "DAI"`, true},
+		{"bare field is truthy", `txValue`, true},
+		// Bare words are fields, a typo never matches
+		{"unquoted value is a field", `txType == uniswapTrade`, false},
+		{"missing field", `txTyp == "uniswapTrade"`, false},
+		{"missing field is null", `txTyp == null`, true},
+		{"missing field is falsy", `!txTyp`, true},
+		// contains
+		{"contains on a list", `tags contains "emitsSwap"`, true},
+		{"contains on a list, no match", `tags contains "willRevert"`, false},
+		{"contains on a string", `venue contains "uniswap"`, true},
+		{"contains on a string, no match", `venue contains "sushi"`, false},
+		// Lists match when any element does
+		{"list equality", `innerCalls.method == "transfer"`, true},
+		{"list comparison", `innerCalls.depth > 1`, true},
+		{"list comparison, no match", `innerCalls.depth > 2`, false},
+		{"list contains", `innerCalls.method contains "swap"`, true},
+		// Known tokens match their symbol, other addresses don't get one
+		{"known token symbol", `path contains "WETH"`, true},
+		{"known token symbol, no match", `path contains "DAI"`, false},
+		{"address itself", `path contains "0x000000000000000000000000000000000000dead"`, true},
+	}
+	doc := newRuleTestDocument(t)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			node, err := parseRuleExpr(test.expr)
+			if err != nil {
+				t.Fatalf("parse %q: %v", test.expr, err)
+			}
+			if got := doc.eval(node); got != test.want {
+				t.Errorf("%q = %v, want %v", test.expr, got, test.want)
+			}
+		})
+	}
+}
+
+func TestRuleExprErrors(t *testing.T) {
+	tests := []string{
+		`txType == "uniswapTrade`,
+		`(txValue > 1`,
+		`txValue > 1)`,
+		`txValue >`,
+		`txValue > 1 &&`,
+		`txValue # 1`,
+	}
+	for _, expr := range tests {
+		if _, err := parseRuleExpr(expr); err == nil {
+			t.Errorf("%q parsed, expected an error", expr)
+		}
+	}
+}
+
+func TestRuleAggregates(t *testing.T) {
+	events := []ruleEvent{
+		{Values: map[string]interface{}{"amountInUSD": 100.0, "path": []interface{}{"0xA", "0xB"}}},
+		{Values: map[string]interface{}{"amountInUSD": "300", "path": []interface{}{"0xa", "0xC"}}},
+		{Values: map[string]interface{}{"amountInUSD": 200.0}},
+		{Values: map[string]interface{}{"amountInUSD": "n/a"}},
+	}
+	tests := []struct {
+		aggregate ruleAggregate
+		want      float64
+	}{
+		{ruleAggregate{Name: "count", Fn: "count"}, 4},
+		{ruleAggregate{Name: "sum(amountInUSD)", Fn: "sum", Field: "amountInUSD"}, 600},
+		{ruleAggregate{Name: "avg(amountInUSD)", Fn: "avg", Field: "amountInUSD"}, 200},
+		{ruleAggregate{Name: "min(amountInUSD)", Fn: "min", Field: "amountInUSD"}, 100},
+		{ruleAggregate{Name: "max(amountInUSD)", Fn: "max", Field: "amountInUSD"}, 300},
+		{ruleAggregate{Name: "distinct(path)", Fn: "distinct", Field: "path"}, 3},
+		{ruleAggregate{Name: "sum(missing)", Fn: "sum", Field: "missing"}, 0},
+	}
+	for _, test := range tests {
+		results := aggregateRuleEvents(events, []ruleAggregate{test.aggregate})
+		if got := results[test.aggregate.Name]; got != test.want {
+			t.Errorf("%s = %v, want %v", test.aggregate.Name, got, test.want)
+		}
+	}
+}
+
+func TestRuleWindow(t *testing.T) {
+	r := &rule{
+		Name:    "repeatTrader",
+		When:    `txType == "uniswapTrade"`,
+		Window:  &ruleWindow{Duration: "10m", GroupBy: "from", Having: "count >= 2 && sum(amountInUSD) > 200000"},
+		Actions: []ruleAction{{Type: ruleActionLog}},
+	}
+	if err := compileRule(r); err != nil {
+		t.Fatal(err)
+	}
+	doc := newRuleTestDocument(t)
+	start := time.Now()
+	tests := []struct {
+		name string
+		at   time.Duration
+		want bool
+	}{
+		{"first match", 0, false},
+		{"second match inside the window", 5 * time.Minute, true},
+		{"both matches expired", 16 * time.Minute, false},
+		{"two matches inside the window again", 20 * time.Minute, true},
+	}
+	for _, test := range tests {
+		group, aggregates, fire := r.updateWindow(doc, start.Add(test.at))
+		if fire != test.want {
+			t.Errorf("%s: fired %v, want %v (aggregates %v)", test.name, fire, test.want, aggregates)
+		}
+		if group != "0xsender" {
+			t.Errorf("%s: group %q, want 0xsender", test.name, group)
+		}
+	}
+}
+
+func TestCompileRuleErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		rule rule
+	}{
+		{"no name", rule{When: "txValue > 1"}},
+		{"bad when", rule{Name: "r", When: "txValue >"}},
+		{"bad cooldown", rule{Name: "r", When: "txValue > 1", Cooldown: "soon"}},
+		{"bad duration", rule{Name: "r", When: "txValue > 1", Window: &ruleWindow{Duration: "0s", Having: "count > 1"}}},
+		{"unknown aggregate", rule{Name: "r", When: "txValue > 1", Window: &ruleWindow{Duration: "1m", Having: "median(txValue) > 1"}}},
+		{"webhook without url", rule{Name: "r", When: "txValue > 1", Actions: []ruleAction{{Type: ruleActionWebhook}}}},
+		{"unknown action", rule{Name: "r", When: "txValue > 1", Actions: []ruleAction{{Type: "email"}}}},
+	}
+	for _, test := range tests {
+		r := test.rule
+		if err := compileRule(&r); err == nil {
+			t.Errorf("%s: compiled, expected an error", test.name)
+		}
+	}
+}
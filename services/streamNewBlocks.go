@@ -3,7 +3,6 @@ package services
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -11,21 +10,30 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
-func handleBlock(blockHash common.Hash, client *ethclient.Client) {
+func handleBlock(blockHash common.Hash, client *ethclient.Client, fullMode bool) {
 	block, err := client.BlockByHash(context.Background(), blockHash)
 	if err != nil {
-		log.Fatal(err)
+		fmt.Println("Error fetching block:", blockHash.Hex(), err)
+		return
 	}
 	//filters.TransfersInBlock(block, client)
 	// Test output from the channel by logging it
-
-	pipeBlock(block)
+	fmt.Println("MINED: Block #", block.Number())
+	// Mined oracle submissions leave the pending order book
+	removeMinedOracleSubmissions(block, client, fullMode)
+	// Pick up any aggregators we haven't seen a submit for yet
+	discoverChainlinkFeedsInBlock(block, client)
+	// Mined blocks aren't indexed, so deployments and watchlist follow-ups are reported here in both modes
+	printMinedDeployments(block, client)
+	alertWatchlistMinedInBlock(block, client)
 	// Find out all the transactions that emit ERC20 transfer event
 	//fmt.Println(".....")
 
 }
 
-func StreamNewBlocks(client *rpc.Client) {
+// Runs next to StreamNewTxs, keeps the in-memory state (oracle order book, feed registry) in sync with what got mined
+// Mined blocks aren't indexed yet (pipeBlock), that path still has to stop exiting on the first failed query
+func StreamNewBlocks(client *rpc.Client, fullMode bool) {
 	// Go channel to pipe data from client subscriptions
 	newBlocksChannel := make(chan *types.Header, 10)
 
@@ -46,8 +54,9 @@ func StreamNewBlocks(client *rpc.Client) {
 		// Code block is executed when a new block is piped to the channel
 		case lastBlockHeader := <-newBlocksChannel:
 			fmt.Println("New block in channel")
+			setLatestHead(lastBlockHeader)
 			func() {
-				go handleBlock(lastBlockHeader.Hash(), GetCurrentClient())
+				go handleBlock(lastBlockHeader.Hash(), GetCurrentClient(), fullMode)
 			}()
 		}
 	}
@@ -24,6 +24,10 @@ func StreamNewTxs(rpcClient *rpc.Client, fullMode bool) {
 	)
 	client := GetCurrentClient()
 	fmt.Println("Subscribed to mempool txs")
+	// miscTx documents, inner calls and deployments all use the 4byte database, load it before the first lookup blocks the stream on it
+	go preloadFourBytesDB()
+	preloadABIRegistry()
+	preloadCompoundMarkets(client)
 
 	// Configure chain ID and signer to ensure you're configured to mainnet
 	chainID, _ := client.NetworkID(context.Background())
@@ -49,4 +53,9 @@ func handleTransaction(tx *types.Transaction, client *ethclient.Client, isStealt
 	// Log the tx and pass it through the classifier
 	//fmt.Println("New TX, hash: ", tx.Hash().String())
 	txClassifier(tx, client, isStealth, fullMode)
+	// Full mode checks the rules and the watchlist against the indexed documents (see pipeTxs.go)
+	if !fullMode {
+		applyRules(tx, client, isStealth, nil)
+		alertWatchlist(tx, client, isStealth, nil)
+	}
 }
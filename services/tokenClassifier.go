@@ -0,0 +1,257 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	. "github.com/logrusorgru/aurora"
+	"github.com/taarushv/helios/contracts/nft"
+)
+
+// Token calls besides plain ERC20 transfer/approve (see pipeTxs.go for those)
+// transferFrom(address,address,uint256) is shared by ERC20 and ERC721, so the token's standard is looked up through ERC165 supportsInterface and cached
+var erc721Abi, _ = abi.JSON(strings.NewReader(nft.ERC721ABI))
+var erc1155Abi, _ = abi.JSON(strings.NewReader(nft.ERC1155ABI))
+
+const (
+	tokenStandardERC20   = "erc20"
+	tokenStandardERC721  = "erc721"
+	tokenStandardERC1155 = "erc1155"
+)
+
+// ERC165 interface ids
+var erc721InterfaceId = [4]byte{0x80, 0xac, 0x58, 0xcd}
+var erc1155InterfaceId = [4]byte{0xd9, 0xb6, 0x7a, 0x26}
+
+// A contract's standard doesn't change, so entries are kept for good
+var tokenStandardCache = struct {
+	lock      sync.Mutex
+	standards map[common.Address]string
+}{
+	standards: make(map[common.Address]string),
+}
+
+type tokenTransfer struct {
+	Standard       string   `json:"tokenStandard"` // "erc20", "erc721" or "erc1155"
+	Method         string   `json:"method"`        // "transferFrom", "safeTransferFrom" or "safeBatchTransferFrom"
+	TokenTo        string   `json:"tokenTo"`
+	TokenFrom      string   `json:"tokenFrom"`
+	TokenAmount    float64  `json:"tokenAmount"` // ERC20 only, with decimals
+	TokenAddress   string   `json:"tokenAddress"`
+	TokenSymbol    string   `json:"tokenSymbol"`
+	TokenIds       []string `json:"tokenIds,omitempty"`
+	TokenIdAmounts []string `json:"tokenIdAmounts,omitempty"` // ERC1155 only, amount of every id
+}
+
+type erc20Allowance struct {
+	TokensSpender string  `json:"tokensSpender"`
+	TokenAddress  string  `json:"tokenAddress"`
+	TokenSymbol   string  `json:"tokenSymbol"`
+	AddedAmount   float64 `json:"addedAmount"` // increaseAllowance only, on top of the current allowance
+}
+
+type wethWrap struct {
+	Action  string  `json:"action"` // "deposit" (wrap) or "withdraw" (unwrap)
+	Amount  float64 `json:"amount"`
+	Account string  `json:"account"`
+}
+
+// ERC165 lookup, anything that doesn't claim ERC721/ERC1155 (including contracts without supportsInterface) is treated as ERC20
+// Only answers from the contract are cached, an RPC error gives ERC20 for this tx and a fresh lookup for the next one
+func getTokenStandard(token common.Address, client *ethclient.Client) string {
+	tokenStandardCache.lock.Lock()
+	standard, ok := tokenStandardCache.standards[token]
+	tokenStandardCache.lock.Unlock()
+	if ok {
+		return standard
+	}
+	standard = tokenStandardERC20
+	tokenInstance, _ := nft.NewERC721(token, client)
+	supported, err := tokenInstance.SupportsInterface(nil, erc721InterfaceId)
+	if err == nil && supported {
+		standard = tokenStandardERC721
+	} else if err == nil || isContractCallRejected(err) {
+		supported, err = tokenInstance.SupportsInterface(nil, erc1155InterfaceId)
+		if err == nil && supported {
+			standard = tokenStandardERC1155
+		}
+	}
+	if err != nil && !isContractCallRejected(err) {
+		fmt.Println("Error looking up the token standard:", token.Hex(), err)
+		return standard
+	}
+	tokenStandardCache.lock.Lock()
+	tokenStandardCache.standards[token] = standard
+	tokenStandardCache.lock.Unlock()
+	return standard
+}
+
+func isWETHCall(tx *types.Transaction) bool {
+	return *tx.To() == wethAddress && (bytes.Equal(tx.Data()[:4], wethDeposit) || bytes.Equal(tx.Data()[:4], wethWithdraw))
+}
+
+func isNFTSafeTransfer(tx *types.Transaction) bool {
+	selector := tx.Data()[:4]
+	return bytes.Equal(selector, erc721SafeTransferFrom) || bytes.Equal(selector, erc721SafeTransferFromData) ||
+		bytes.Equal(selector, erc1155SafeTransferFrom) || bytes.Equal(selector, erc1155SafeBatchTransferFrom)
+}
+
+// transferFrom(from, to, amount/tokenId), indexed as an ERC20 transfer or an NFT transfer depending on the token
+func handleTokenTransferFrom(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+	var params struct {
+		From    common.Address
+		To      common.Address
+		TokenId *big.Int
+	}
+	if err := erc721Abi.Methods["transferFrom"].Inputs.Unpack(&params, tx.Data()[4:]); err != nil {
+		fmt.Println("Error decoding transferFrom:", tx.Hash().Hex(), err)
+		if fullMode {
+			handleMiscTx(tx, client, isStealth)
+		}
+		return
+	}
+	final := tokenTransfer{
+		Standard:     getTokenStandard(*tx.To(), client),
+		Method:       "transferFrom",
+		TokenTo:      params.To.Hex(),
+		TokenFrom:    params.From.Hex(),
+		TokenAddress: tx.To().Hex(),
+		TokenSymbol:  getTokenSymbol(*tx.To(), client),
+	}
+	fmt.Println()
+	if final.Standard == tokenStandardERC721 {
+		final.TokenIds = []string{params.TokenId.String()}
+		fmt.Println(Blue("New TX: ERC721 Transfer"))
+		fmt.Println("Hash: ", tx.Hash().Hex(), " Token: ", final.TokenSymbol, " Id: ", final.TokenIds[0])
+		if fullMode {
+			handleNFTTransfer(tx, client, isStealth, final)
+		}
+		return
+	}
+	final.TokenAmount = formatERC20Decimals(params.TokenId, *tx.To(), client)
+	fmt.Println(Blue("New TX: ERC20 TransferFrom"))
+	fmt.Println("Hash: ", tx.Hash().Hex(), " Amount: ", final.TokenAmount, final.TokenSymbol)
+	if fullMode {
+		handleERC20TransferFrom(tx, client, isStealth, final)
+	}
+}
+
+func handleERC20IncreaseAllowance(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+	if len(tx.Data()) < 68 {
+		if fullMode {
+			handleMiscTx(tx, client, isStealth)
+		}
+		return
+	}
+	addedValue := new(big.Int).SetBytes(tx.Data()[36:68])
+	final := erc20Allowance{
+		TokensSpender: common.BytesToAddress(tx.Data()[4:36]).Hex(),
+		TokenAddress:  tx.To().Hex(),
+		TokenSymbol:   getTokenSymbol(*tx.To(), client),
+		AddedAmount:   formatERC20Decimals(addedValue, *tx.To(), client),
+	}
+	fmt.Println()
+	fmt.Println(Blue("New TX: ERC20 Increase Allowance"))
+	fmt.Println("Hash: ", tx.Hash().Hex(), " Token: ", final.TokenSymbol, " Added: ", final.AddedAmount)
+	if fullMode {
+		handleERC20IncreaseAllowanceFinal(tx, client, isStealth, final)
+	}
+}
+
+func handleWETHCall(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+	final := wethWrap{Action: "deposit", Amount: formatEthWeiToEther(tx.Value()), Account: getTxSenderAddress(tx, client)}
+	if bytes.Equal(tx.Data()[:4], wethWithdraw) {
+		if len(tx.Data()) < 36 {
+			if fullMode {
+				handleMiscTx(tx, client, isStealth)
+			}
+			return
+		}
+		final.Action = "withdraw"
+		final.Amount = formatEthWeiToEther(new(big.Int).SetBytes(tx.Data()[4:36]))
+	}
+	fmt.Println()
+	fmt.Println(Blue("New TX: WETH " + strings.Title(final.Action)))
+	fmt.Println("Hash: ", tx.Hash().Hex(), " Amount: ", final.Amount)
+	if fullMode {
+		handleWETHWrap(tx, client, isStealth, final)
+	}
+}
+
+// ERC721 safeTransferFrom (with and without data) and ERC1155 safeTransferFrom/safeBatchTransferFrom
+// The selectors are unique to each standard, so there's no need to ask the contract (plenty of NFTs don't implement ERC165)
+func handleNFTSafeTransfer(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+	selector := tx.Data()[:4]
+	final := tokenTransfer{
+		Standard:     tokenStandardERC1155,
+		TokenAddress: tx.To().Hex(),
+		TokenSymbol:  getTokenSymbol(*tx.To(), client),
+	}
+	var err error
+	switch {
+	case bytes.Equal(selector, erc721SafeTransferFrom), bytes.Equal(selector, erc721SafeTransferFromData):
+		var method *abi.Method
+		if method, err = erc721Abi.MethodById(selector); err == nil {
+			var values []interface{}
+			if values, err = method.Inputs.UnpackValues(tx.Data()[4:]); err == nil {
+				final.TokenFrom = values[0].(common.Address).Hex()
+				final.TokenTo = values[1].(common.Address).Hex()
+				final.TokenIds = []string{values[2].(*big.Int).String()}
+			}
+		}
+		final.Standard = tokenStandardERC721
+		final.Method = "safeTransferFrom"
+	case bytes.Equal(selector, erc1155SafeTransferFrom):
+		var params struct {
+			From   common.Address
+			To     common.Address
+			Id     *big.Int
+			Amount *big.Int
+			Data   []byte
+		}
+		if err = erc1155Abi.Methods["safeTransferFrom"].Inputs.Unpack(&params, tx.Data()[4:]); err == nil {
+			final.TokenFrom, final.TokenTo = params.From.Hex(), params.To.Hex()
+			final.TokenIds = []string{params.Id.String()}
+			final.TokenIdAmounts = []string{params.Amount.String()}
+		}
+		final.Method = "safeTransferFrom"
+	case bytes.Equal(selector, erc1155SafeBatchTransferFrom):
+		var params struct {
+			From    common.Address
+			To      common.Address
+			Ids     []*big.Int
+			Amounts []*big.Int
+			Data    []byte
+		}
+		if err = erc1155Abi.Methods["safeBatchTransferFrom"].Inputs.Unpack(&params, tx.Data()[4:]); err == nil {
+			final.TokenFrom, final.TokenTo = params.From.Hex(), params.To.Hex()
+			for i, id := range params.Ids {
+				final.TokenIds = append(final.TokenIds, id.String())
+				if i < len(params.Amounts) {
+					final.TokenIdAmounts = append(final.TokenIdAmounts, params.Amounts[i].String())
+				}
+			}
+		}
+		final.Method = "safeBatchTransferFrom"
+	}
+	if err != nil {
+		fmt.Println("Error decoding NFT transfer:", tx.Hash().Hex(), err)
+		if fullMode {
+			handleMiscTx(tx, client, isStealth)
+		}
+		return
+	}
+	fmt.Println()
+	fmt.Println(Blue("New TX: " + strings.ToUpper(final.Standard) + " Transfer"))
+	fmt.Println("Hash: ", tx.Hash().Hex(), " Token: ", final.TokenAddress, final.TokenSymbol, " Ids: ", final.TokenIds)
+	if fullMode {
+		handleNFTTransfer(tx, client, isStealth, final)
+	}
+}
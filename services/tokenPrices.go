@@ -0,0 +1,123 @@
+package services
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/taarushv/helios/contracts/chainlinkACA"
+)
+
+// USD prices for trade sizes, read from the chainlink feeds in the feed registry
+// Only the tokens listed below are priced, by address: symbol() is whatever the token says it is, so any token could claim to be WETH
+// A token is priced through its X/USD feed, or X/ETH * ETH/USD when there's only an ETH feed
+// Answers are cached for a minute, trades only need a ballpark figure
+
+const tokenPriceCacheSeconds = 60
+
+type cachedPrice struct {
+	Price     float64
+	Ok        bool
+	FetchedAt int64
+}
+
+type knownToken struct {
+	Symbol    string
+	FeedAsset string // X in the X/USD and X/ETH feed pairs
+}
+
+// Mainnet tokens with a chainlink feed, the symbols double as names in rules (see ruleEngine.go)
+var knownTokens = map[common.Address]knownToken{
+	wethAddress: {"WETH", "ETH"},
+	common.HexToAddress("0x2260FAC5E5542a773Aa44fBCfeDf7C193bc2C599"): {"WBTC", "BTC"},
+	common.HexToAddress("0x6B175474E89094C44Da98b954EedeAC495271d0F"): {"DAI", "DAI"},
+	common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"): {"USDC", "USDC"},
+	common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7"): {"USDT", "USDT"},
+	common.HexToAddress("0x514910771AF9Ca656af840dff83E8264EcF986CA"): {"LINK", "LINK"},
+	common.HexToAddress("0x1f9840a85d5aF5bf1D1762F925BDADdC4201F984"): {"UNI", "UNI"},
+	common.HexToAddress("0x7Fc66500c84A76Ad7e9c93437bFc5Ac33E2DDaE9"): {"AAVE", "AAVE"},
+	common.HexToAddress("0xc00e94Cb662C3520282E6f5717214004A7f26888"): {"COMP", "COMP"},
+	common.HexToAddress("0x9f8F72aA9304c8B593d555F12eF6589cC3A579A2"): {"MKR", "MKR"},
+	common.HexToAddress("0xC011a73ee8576Fb46F5E1c5751cA3B9Fe0af2a6F"): {"SNX", "SNX"},
+	common.HexToAddress("0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e"): {"YFI", "YFI"},
+}
+
+var tokenPriceCache = struct {
+	lock sync.Mutex
+	// Feed pair => answer
+	pairs map[string]cachedPrice
+}{
+	pairs: make(map[string]cachedPrice),
+}
+
+// Latest answer of the feed for `pair`, scaled by its decimals
+func getChainlinkPairPrice(pair string, client *ethclient.Client) (float64, bool) {
+	now := time.Now().Unix()
+	tokenPriceCache.lock.Lock()
+	cached, ok := tokenPriceCache.pairs[pair]
+	tokenPriceCache.lock.Unlock()
+	if ok && cached.FetchedAt+tokenPriceCacheSeconds > now {
+		return cached.Price, cached.Ok
+	}
+	cached = cachedPrice{FetchedAt: now}
+	if feed, ok := lookupChainlinkFeedByPair(pair); ok {
+		// Flux and OCR aggregators both implement the v2 aggregator interface
+		aggregator, err := chainlinkACA.NewChainlinkACA(common.HexToAddress(feed.ContractAddress), client)
+		if err == nil {
+			answer, err := aggregator.LatestAnswer(nil)
+			decimals := feed.Decimals
+			if decimals == 0 {
+				decimals, _ = aggregator.Decimals(nil)
+			}
+			if err == nil && answer.Sign() > 0 {
+				denominator := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+				cached.Price, _ = new(big.Float).Quo(new(big.Float).SetInt(answer), denominator).Float64()
+				cached.Ok = true
+			}
+		}
+	}
+	tokenPriceCache.lock.Lock()
+	tokenPriceCache.pairs[pair] = cached
+	tokenPriceCache.lock.Unlock()
+	return cached.Price, cached.Ok
+}
+
+func getTokenPriceUSD(token common.Address, client *ethclient.Client) (float64, bool) {
+	known, ok := knownTokens[token]
+	if !ok {
+		return 0, false
+	}
+	symbol := known.FeedAsset
+	if price, ok := getChainlinkPairPrice(symbol+"/USD", client); ok {
+		return price, true
+	}
+	priceETH, ok := getChainlinkPairPrice(symbol+"/ETH", client)
+	if !ok {
+		return 0, false
+	}
+	ethUSD, ok := getChainlinkPairPrice("ETH/USD", client)
+	return priceETH * ethUSD, ok
+}
+
+// Size of a trade in USD, priced on the input token (expected input for exact output trades). 0 when there's no feed for it
+// Aggregator and pool trades can start from an ETH placeholder, it's priced as WETH
+func getTradeAmountInUSD(final UniswapTradeFinal, client *ethclient.Client) float64 {
+	if len(final.Path) == 0 || !common.IsHexAddress(final.Path[0]) {
+		return 0
+	}
+	amount := final.AmountIn
+	if amount == 0 {
+		amount = final.ExpectedAmountIn
+	}
+	tokenIn := common.HexToAddress(final.Path[0])
+	if isEthPlaceholder(tokenIn) {
+		tokenIn = wethAddress
+	}
+	price, ok := getTokenPriceUSD(tokenIn, client)
+	if !ok {
+		return 0
+	}
+	return amount * price
+}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	. "github.com/logrusorgru/aurora"
@@ -12,10 +14,160 @@ import (
 // Store function signature bytes for quick classification
 var erc20Transfer = []byte{0xa9, 0x05, 0x9c, 0xbb}
 var erc20Approve = []byte{0x09, 0x5e, 0xa7, 0xb3}
+var erc20TransferFrom = []byte{0x23, 0xb8, 0x72, 0xdd} // Same selector for ERC721 transferFrom
+var erc20IncreaseAllowance = []byte{0x39, 0x50, 0x93, 0x51}
+var wethDeposit = []byte{0xd0, 0xe3, 0x0d, 0xb0}
+var wethWithdraw = []byte{0x2e, 0x1a, 0x7d, 0x4d}
+var erc721SafeTransferFrom = []byte{0x42, 0x84, 0x2e, 0x0e}       // safeTransferFrom(address,address,uint256)
+var erc721SafeTransferFromData = []byte{0xb8, 0x8d, 0x4f, 0xde}   // safeTransferFrom(address,address,uint256,bytes)
+var erc1155SafeTransferFrom = []byte{0xf2, 0x42, 0x43, 0x2a}      // safeTransferFrom(address,address,uint256,uint256,bytes)
+var erc1155SafeBatchTransferFrom = []byte{0x2e, 0xb2, 0xc2, 0xd6} // safeBatchTransferFrom(address,address,uint256[],uint256[],bytes)
 var linkOracleUpdate = []byte{0x20, 0x2e, 0xe0, 0xed}
 
-// Store contract addresses for quick classification
-var uniV2routerAddress = "0x7a250d5630B4cF539739dF2C5dAcb4c659F2488D"
+// A filter in the classifier chain, `match` checks the tx (and passes along anything it had to look up, like the dex venue or chainlink feed)
+// and `handle` prints + indexes it. The chain is shared with the nested call decoder (nestedCalls.go) so inner calls get classified the same way
+type txFilter struct {
+	Name   string
+	match  func(tx *types.Transaction, client *ethclient.Client) (interface{}, bool)
+	handle func(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, matched interface{})
+}
+
+// Filters are checked in order, the first match wins. Populated in init() since the handlers end up referring back to the chain
+var txFilters []*txFilter
+
+func matchSelector(selector []byte) func(tx *types.Transaction, client *ethclient.Client) (interface{}, bool) {
+	return func(tx *types.Transaction, client *ethclient.Client) (interface{}, bool) {
+		return nil, bytes.Equal(tx.Data()[:4], selector)
+	}
+}
+
+func matchPredicate(predicate func(tx *types.Transaction) bool) func(tx *types.Transaction, client *ethclient.Client) (interface{}, bool) {
+	return func(tx *types.Transaction, client *ethclient.Client) (interface{}, bool) {
+		return nil, predicate(tx)
+	}
+}
+
+func handleUnmatched(handler func(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool)) func(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, matched interface{}) {
+	return func(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, matched interface{}) {
+		handler(tx, client, isStealth, fullMode)
+	}
+}
+
+// What isKnownContract found, passed from match to handle
+type knownContract struct {
+	Name       string
+	ABIAddress common.Address
+	ABI        *abi.ABI
+}
+
+func init() {
+	txFilters = []*txFilter{
+		// Standard ERC20 Approve and Transfer
+		{Name: "erc20Approve", match: matchSelector(erc20Approve), handle: handleUnmatched(handleERC20Approve)},
+		{Name: "erc20Transfer", match: matchSelector(erc20Transfer), handle: handleUnmatched(handleERC20Transfer)},
+		// ERC20 and ERC721 transferFrom (told apart via supportsInterface)
+		{Name: "erc20TransferFrom", match: matchSelector(erc20TransferFrom), handle: handleUnmatched(handleTokenTransferFrom)},
+		{Name: "erc20IncreaseAllowance", match: matchSelector(erc20IncreaseAllowance), handle: handleUnmatched(handleERC20IncreaseAllowance)},
+		// WETH wraps/unwraps
+		{Name: "wethWrap", match: matchPredicate(isWETHCall), handle: handleUnmatched(handleWETHCall)},
+		// ERC721 and ERC1155 safe transfers
+		{Name: "nftTransfer", match: matchPredicate(isNFTSafeTransfer), handle: handleUnmatched(handleNFTSafeTransfer)},
+		// Proxy upgrades (upgradeTo and friends), high priority security events
+		{Name: "proxyUpgrade", match: matchPredicate(isProxyUpgrade), handle: handleUnmatched(handleProxyUpgrade)},
+		// Uniswap V3 trades
+		{Name: "uniswapV3", match: matchPredicate(isUniswapV3Router), handle: handleUnmatched(handleUniswapV3Trade)},
+		// 1inch and 0x aggregator trades
+		{
+			Name: "aggregator",
+			match: func(tx *types.Transaction, client *ethclient.Client) (interface{}, bool) {
+				return isAggregatorRouter(tx)
+			},
+			handle: func(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, matched interface{}) {
+				handleAggregatorTrade(tx, client, isStealth, fullMode, matched.(string))
+			},
+		},
+		// Curve pool swaps
+		{Name: "curve", match: matchPredicate(isCurveExchange), handle: handleUnmatched(handleCurveExchange)},
+		// Balancer V1 pool swaps
+		{Name: "balancer", match: matchPredicate(isBalancerPoolSwap), handle: handleUnmatched(handleBalancerPoolSwap)},
+		// Balancer V2 vault swaps
+		{Name: "balancerVault", match: matchPredicate(isBalancerVaultSwap), handle: handleUnmatched(handleBalancerVaultSwap)},
+		// Uniswap (and V2 fork) related trades
+		{
+			Name: "uniswapV2",
+			match: func(tx *types.Transaction, client *ethclient.Client) (interface{}, bool) {
+				return lookupDexVenue(*tx.To())
+			},
+			handle: func(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, matched interface{}) {
+				handleUniswapTrade(tx, client, isStealth, fullMode, matched.(*dexVenue))
+			},
+		},
+		// Aave deposits, borrows, repays, flash loans and liquidations
+		{Name: "aave", match: matchPredicate(isAaveLendingPool), handle: handleUnmatched(handleAaveAction)},
+		// Compound cToken actions and liquidations
+		{
+			Name: "compound",
+			match: func(tx *types.Transaction, client *ethclient.Client) (interface{}, bool) {
+				return nil, isCompoundMarket(tx, client)
+			},
+			handle: handleUnmatched(handleCompoundAction),
+		},
+		// Chainlink oracle updates
+		{
+			Name: "chainlink",
+			match: func(tx *types.Transaction, client *ethclient.Client) (interface{}, bool) {
+				return isChainlinkOracleUpdate(tx, client)
+			},
+			handle: func(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, matched interface{}) {
+				handleChainlinkOracleUpdate(tx, client, isStealth, fullMode, matched.(*chainlinkFeed))
+			},
+		},
+		// Chainlink OCR reports
+		{
+			Name: "chainlinkOCR",
+			match: func(tx *types.Transaction, client *ethclient.Client) (interface{}, bool) {
+				return isChainlinkOCRTransmit(tx, client)
+			},
+			handle: func(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, matched interface{}) {
+				handleChainlinkOCRTransmit(tx, client, isStealth, fullMode, matched.(*chainlinkFeed))
+			},
+		},
+		// dYdX perpetual funding rate updates
+		{Name: "dydxFundingRate", match: matchPredicate(isDydxFundingRateUpdate), handle: handleUnmatched(handleDydxFundingRateUpdate)},
+		// Maker OSM/median pokes
+		{
+			Name: "makerOracle",
+			match: func(tx *types.Transaction, client *ethclient.Client) (interface{}, bool) {
+				return isMakerOracleUpdate(tx, client)
+			},
+			handle: func(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, matched interface{}) {
+				handleMakerOraclePoke(tx, client, isStealth, fullMode, matched.(*makerOracle))
+			},
+		},
+		// Contracts with a full ABI in the local registry
+		{
+			Name: "contractCall",
+			match: func(tx *types.Transaction, client *ethclient.Client) (interface{}, bool) {
+				name, abiAddress, contractAbi, ok := isKnownContract(tx, client)
+				return &knownContract{Name: name, ABIAddress: abiAddress, ABI: contractAbi}, ok
+			},
+			handle: func(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, matched interface{}) {
+				contract := matched.(*knownContract)
+				handleContractCall(tx, client, isStealth, fullMode, contract.Name, contract.ABIAddress, contract.ABI)
+			},
+		},
+	}
+}
+
+// First filter in the chain that claims the tx, nil if none do. The tx needs a recipient and a 4 byte selector
+func matchTxFilter(tx *types.Transaction, client *ethclient.Client) (*txFilter, interface{}) {
+	for _, filter := range txFilters {
+		if matched, ok := filter.match(tx, client); ok {
+			return filter, matched
+		}
+	}
+	return nil, nil
+}
 
 // Core classifier to tag txs in the mempool before they're executed
 // We classify a tx and then pipe it into elastic search as a document entry
@@ -34,33 +186,27 @@ func txClassifier(tx *types.Transaction, client *ethclient.Client, isStealth boo
 	} else {
 		// If the tx has no recepient it's a contract deployment
 		if tx.To() == nil {
-			fmt.Println(White("New TX: Contract Deployment"))
-			fmt.Println("Hash: ", tx.Hash().Hex())
-			if fullMode {
-				handleContractDeployment(tx, client, isStealth)
-			}
+			handleNewContract(tx, client, isStealth, fullMode)
 		} else {
 			// Now that we've ruled out the base cases, we classify contract interactions via function signature
 			// We also have a KV pair of fn signatures + identifiers in the "4bytes" index (for use in nested methods)
-			// "filters" and their assosiated insert methods go here
+			// "filters" and their assosiated insert methods go here (see txFilters above)
 			if len(tx.Data()) >= 4 {
-				// Check first 4 bytes against our filters
-				if bytes.Equal(tx.Data()[:4], erc20Approve) { // Standard ERC20 Approve and Transfer
-					handleERC20Approve(tx, client, isStealth, fullMode)
-				} else if bytes.Equal(tx.Data()[:4], erc20Transfer) {
-					handleERC20Transfer(tx, client, isStealth, fullMode)
-				} else if tx.To().Hex() == uniV2routerAddress { // Uniswap related trades
-					handleUniswapTrade(tx, client, isStealth, fullMode)
-				} else if bytes.Equal(tx.Data()[:4], linkOracleUpdate) { // Chainlink oracle updates
-					handleChainlinkOracleUpdate(tx, client, isStealth, fullMode)
+				// Check the tx against our filters
+				if filter, matched := matchTxFilter(tx, client); filter != nil {
+					filter.handle(tx, client, isStealth, fullMode, matched)
 				} else {
 					// "Everything else" for now, until I add more filters
-					// TODO: Identify method by querying "4bytes"
+					// Method + args are filled in from the offline 4byte database when the selector is known
 					if fullMode {
 						handleMiscTx(tx, client, isStealth)
 					}
 
 				}
+				// Wrapped calls (multicall, Safe execTransaction etc) are listed under the outer tx, see nestedCalls.go
+				printInnerCalls(tx, client)
+				// Calls to known bot contracts (and their redeploys), see bytecodeFingerprint.go
+				printBotCluster(tx, client)
 			} else {
 				if fullMode {
 					// Weird txs (<4 bytes indicates no function identifier, likely a wallet error or using data field to stamp bytes)
@@ -71,5 +217,18 @@ func txClassifier(tx *types.Transaction, client *ethclient.Client, isStealth boo
 
 		}
 	}
+	// Names for the sender and recipient (exchanges, routers, bots...), see addressLabels.go
+	printAddressLabels(tx, client)
+}
 
+// `submit` calls only count as oracle updates when they hit a verified aggregator from one of its oracles (see chainlinkFeedRegistry.go)
+func isChainlinkOracleUpdate(tx *types.Transaction, client *ethclient.Client) (*chainlinkFeed, bool) {
+	if !bytes.Equal(tx.Data()[:4], linkOracleUpdate) || len(tx.Data()) < 68 {
+		return nil, false
+	}
+	feed, ok := isAuthorisedChainlinkSubmission(tx, client)
+	if !ok || feed.Type == chainlinkFeedTypeOCR {
+		return nil, false
+	}
+	return feed, true
 }
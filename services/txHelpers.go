@@ -7,11 +7,14 @@ import (
 	"fmt"
 	"log"
 	"math/big"
+	"strings"
 
 	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/taarushv/helios/contracts/erc20"
 )
 
@@ -134,14 +137,18 @@ func TxMinedUpdate(txHash string, client *ethclient.Client) {
 			TxMined       bool  `json:"txMined"`
 			TxFailed      bool  `json:"txFailed"`
 			BlockIncluded int64 `json:"blockIncluded"`
+			// Deployments only, the deployed code replaces what we guessed from the init code
+			Deployment *minedDeployment `json:"deployment,omitempty"`
 		}
 		body := struct {
 			Doc final `json:"doc"`
 		}{}
 
 		body.Doc = final{TxMined: isTxMined(txHash, client), TxFailed: hasTxFailed(txHash, client), BlockIncluded: getBlockNoByTxHash(txHash, client)}
+		body.Doc.Deployment = getMinedDeployment(txHash, client)
 		es, _ := elasticsearch.NewDefaultClient()
 		jsonBytes, _ := json.Marshal(body)
+		alertWatchlistMined(txHash, jsonBytes)
 		// tag:a0f4e902d18460337684d74ea932fbe9[]
 		res, err := es.Update(
 			"transactions",
@@ -205,3 +212,16 @@ func getTokenName(tokenAddress common.Address, client *ethclient.Client) string
 	name, _ := tokenIntance.Name(nil)
 	return name
 }
+
+// Reverts, missing code and return data that doesn't decode mean the contract answered (and doesn't implement what we called)
+// Anything else (timeouts, dropped connections, rate limits) says nothing about the contract
+func isContractCallRejected(err error) bool {
+	if err == bind.ErrNoCode || strings.HasPrefix(err.Error(), "abi:") {
+		return true
+	}
+	if _, ok := err.(rpc.Error); ok {
+		message := strings.ToLower(err.Error())
+		return strings.Contains(message, "revert") || strings.Contains(message, "invalid opcode")
+	}
+	return false
+}
@@ -1,8 +1,8 @@
 package services
 
 import (
+	"errors"
 	"fmt"
-	"log"
 	"math/big"
 	"strings"
 
@@ -100,13 +100,32 @@ type UniswapExactETHForTokensSupportingFeeOnTransferTokensInput struct {
 }
 
 type UniswapTradeFinal struct {
+	Venue             string   `json:"venue"` // "Uniswap V2", "SushiSwap" etc (see dexVenues.go)
 	AmountIn          float64  `json:"amountIn"`
+	AmountInUSD       float64  `json:"amountInUSD"` // Priced through the chainlink feeds (see tokenPrices.go), 0 when the input token has none
 	AmountOutMin      float64  `json:"amountOutMin"`
 	Path              []string `json:"path"`
 	Deadline          int64    `json:"deadline"`
 	To                string   `json:"to"`
 	OutputTokenSymbol string   `json:"outputTokenSymbol"`
 	OutputTokenName   string   `json:""`
+	// Computed from the current reserves of every hop (see uniswapPriceImpact.go)
+	Pairs               []string `json:"pairs"`
+	ExpectedAmountIn    float64  `json:"expectedAmountIn"`
+	ExpectedAmountOut   float64  `json:"expectedAmountOut"`
+	PriceImpact         float64  `json:"priceImpactPercent"`       // Execution price vs mid price, fee included
+	SlippageTolerance   float64  `json:"slippageTolerancePercent"` // Implied by amountOutMin (or amountInMax for exact output trades)
+	ExceedsSlippage     bool     `json:"exceedsSlippage"`          // Trade reverts at the current reserves
+	MaxFrontrunAmountIn float64  `json:"maxFrontrunAmountIn"`      // Largest front-run (in the input token) that still lets the trade through
+	// Uniswap V3 only
+	FeeTiers          []uint32 `json:"feeTiers,omitempty"`          // Fee of every hop, in hundredths of a bip (3000 = 0.3%)
+	SqrtPriceLimitX96 string   `json:"sqrtPriceLimitX96,omitempty"` // Price limit of single hop swaps, empty if none
+	// Aggregators only, venue of every hop when the route is encoded in the calldata
+	Route []string `json:"route,omitempty"`
+	// Curve and Balancer only
+	CoinIndices []int64  `json:"coinIndices,omitempty"` // Curve i, j
+	Underlying  bool     `json:"underlying,omitempty"`  // Curve exchange_underlying
+	PoolIds     []string `json:"poolIds,omitempty"`     // Balancer V2 pool id of every step
 }
 
 type UniswapAddLiquidityETHInput struct {
@@ -119,6 +138,7 @@ type UniswapAddLiquidityETHInput struct {
 }
 
 type UniswapAddLiquidityETHFinalInput struct {
+	Venue                    string  `json:"venue"`
 	TokenAddress             string  `json:"tokenAddress"`
 	LiquidityProviderAddress string  `json:"liquidityProviderAddress"`
 	Deadline                 int64   `json:"deadline"`
@@ -160,6 +180,7 @@ type UniswapRemoveLiquidityETHSupportingFeeOnTransferTokens struct {
 }
 
 type UniswapRemoveLiquidityETHFinalInput struct {
+	Venue                    string  `json:"venue"`
 	TokenAddress             string  `json:"tokenAddress"`
 	LPTokenAmount            float64 `json:"lPTokenAmount"`
 	AmountTokenMin           float64 `json:"amountTokenMin"`
@@ -206,6 +227,7 @@ type UniswapRemoveLiquidityInput struct {
 }
 
 type UniswapRemoveLiquidityFinalInput struct {
+	Venue      string  `json:"venue"`
 	TokenA     string  `json:"tokenA"`
 	TokenB     string  `json:"tokenB"`
 	AmountAMin float64 `json:"amountAMin"`
@@ -225,6 +247,7 @@ type UniswapAddLiquidityInput struct {
 }
 
 type UniswapAddLiquidityFinalInput struct {
+	Venue                    string  `json:"venue"`
 	TokenAAddress            string  `json:"tokenAAddress"`
 	TokenBAddress            string  `json:"tokenBAddress"`
 	AmountADesired           float64 `json:"amountADesired"`
@@ -243,21 +266,37 @@ type UniswapExactTokensForTokensSupportingFeeOnTransferTokensInput struct {
 	To           common.Address
 }
 
+var errShortSwapPath = errors.New("swap path needs at least two tokens")
+
+// Calldata the router ABI can't decode (non-standard forks, malformed txs) goes to misc instead of taking the stream down
+func handleUndecodedRouterCall(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, venue *dexVenue, err error) {
+	fmt.Println("Error decoding", venue.Name, "call:", tx.Hash().Hex(), err)
+	if fullMode {
+		handleMiscTx(tx, client, isStealth)
+	}
+}
+
 // Functions to trade tokens
 
-func HandleSwapExactETHForTokens(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+func HandleSwapExactETHForTokens(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, venue *dexVenue) {
 	var trade UniswapETHToTokenParsedInput
 	method, _ := routerAbi.MethodById((swapExactETHForTokens)[:])
 	if err := method.Inputs.Unpack(
 		&trade, tx.Data()[4:],
 	); err != nil {
-		log.Fatal(err)
+		handleUndecodedRouterCall(tx, client, isStealth, fullMode, venue, err)
+		return
+	}
+	if len(trade.Path) < 2 {
+		handleUndecodedRouterCall(tx, client, isStealth, fullMode, venue, errShortSwapPath)
+		return
 	}
 	tradePathString := make([]string, len(trade.Path))
 	for i, s := range trade.Path {
 		tradePathString[i] = s.Hex()
 	}
 	final := UniswapTradeFinal{
+		Venue:             venue.Name,
 		AmountIn:          formatEthWeiToEther(tx.Value()),
 		AmountOutMin:      formatERC20Decimals(trade.AmountOutMin, trade.Path[len(trade.Path)-1], client),
 		Path:              tradePathString,
@@ -266,25 +305,32 @@ func HandleSwapExactETHForTokens(tx *types.Transaction, client *ethclient.Client
 		OutputTokenSymbol: getTokenSymbol(trade.Path[len(trade.Path)-1], client),
 		OutputTokenName:   getTokenName(trade.Path[len(trade.Path)-1], client),
 	}
+	addUniswapTradeImpact(&final, venue, trade.Path, tx.Value(), trade.AmountOutMin, false, client)
 	if fullMode {
 		handleUniFinalTrade(tx, client, isStealth, final)
 	}
 
 }
 
-func HandleSwapExactTokensForETH(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+func HandleSwapExactTokensForETH(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, venue *dexVenue) {
 	var trade UniswapTokenToETHParsedInput
 	method, _ := routerAbi.MethodById((swapExactTokensForETH)[:])
 	if err := method.Inputs.Unpack(
 		&trade, tx.Data()[4:],
 	); err != nil {
-		log.Fatal(err)
+		handleUndecodedRouterCall(tx, client, isStealth, fullMode, venue, err)
+		return
+	}
+	if len(trade.Path) < 2 {
+		handleUndecodedRouterCall(tx, client, isStealth, fullMode, venue, errShortSwapPath)
+		return
 	}
 	tradePathString := make([]string, len(trade.Path))
 	for i, s := range trade.Path {
 		tradePathString[i] = s.Hex()
 	}
 	final := UniswapTradeFinal{
+		Venue:             venue.Name,
 		AmountIn:          formatERC20Decimals(trade.AmountIn, trade.Path[0], client),
 		AmountOutMin:      formatEthWeiToEther(trade.AmountOutMin),
 		Path:              tradePathString,
@@ -293,27 +339,34 @@ func HandleSwapExactTokensForETH(tx *types.Transaction, client *ethclient.Client
 		OutputTokenSymbol: "ETH",
 		OutputTokenName:   "Ether",
 	}
+	addUniswapTradeImpact(&final, venue, trade.Path, trade.AmountIn, trade.AmountOutMin, false, client)
 	fmt.Println()
-	fmt.Println(Red("New TX: Uniswap Trade"))
+	fmt.Println(Red("New TX: " + venue.Name + " Trade"))
 	fmt.Println("Hash: ", tx.Hash().Hex(), " Amount: ", final.AmountIn, getTokenSymbol(trade.Path[0], client), " For: ", final.OutputTokenSymbol)
 	if fullMode {
 		handleUniFinalTrade(tx, client, isStealth, final)
 	}
 }
 
-func HandleSwapExactTokensForTokens(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+func HandleSwapExactTokensForTokens(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, venue *dexVenue) {
 	var trade UniswapTokenToTokenParsedInput
 	method, _ := routerAbi.MethodById((swapExactTokensForTokens)[:])
 	if err := method.Inputs.Unpack(
 		&trade, tx.Data()[4:],
 	); err != nil {
-		log.Fatal(err)
+		handleUndecodedRouterCall(tx, client, isStealth, fullMode, venue, err)
+		return
+	}
+	if len(trade.Path) < 2 {
+		handleUndecodedRouterCall(tx, client, isStealth, fullMode, venue, errShortSwapPath)
+		return
 	}
 	tradePathString := make([]string, len(trade.Path))
 	for i, s := range trade.Path {
 		tradePathString[i] = s.Hex()
 	}
 	final := UniswapTradeFinal{
+		Venue:             venue.Name,
 		AmountIn:          formatERC20Decimals(trade.AmountIn, trade.Path[0], client),
 		AmountOutMin:      formatERC20Decimals(trade.AmountOutMin, trade.Path[len(trade.Path)-1], client),
 		Path:              tradePathString,
@@ -322,27 +375,34 @@ func HandleSwapExactTokensForTokens(tx *types.Transaction, client *ethclient.Cli
 		OutputTokenSymbol: getTokenSymbol(trade.Path[len(trade.Path)-1], client),
 		OutputTokenName:   getTokenName(trade.Path[len(trade.Path)-1], client),
 	}
+	addUniswapTradeImpact(&final, venue, trade.Path, trade.AmountIn, trade.AmountOutMin, false, client)
 	fmt.Println()
-	fmt.Println(Red("New TX: Uniswap Trade"))
+	fmt.Println(Red("New TX: " + venue.Name + " Trade"))
 	fmt.Println("Hash: ", tx.Hash().Hex(), " Amount: ", final.AmountIn, getTokenSymbol(trade.Path[0], client), " For: ", final.OutputTokenSymbol)
 	if fullMode {
 		handleUniFinalTrade(tx, client, isStealth, final)
 	}
 }
 
-func HandleSwapETHForExactTokens(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+func HandleSwapETHForExactTokens(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, venue *dexVenue) {
 	var trade UniswapETHToExactTokensInput
 	method, _ := routerAbi.MethodById((swapETHForExactTokens)[:])
 	if err := method.Inputs.Unpack(
 		&trade, tx.Data()[4:],
 	); err != nil {
-		log.Fatal(err)
+		handleUndecodedRouterCall(tx, client, isStealth, fullMode, venue, err)
+		return
+	}
+	if len(trade.Path) < 2 {
+		handleUndecodedRouterCall(tx, client, isStealth, fullMode, venue, errShortSwapPath)
+		return
 	}
 	tradePathString := make([]string, len(trade.Path))
 	for i, s := range trade.Path {
 		tradePathString[i] = s.Hex()
 	}
 	final := UniswapTradeFinal{
+		Venue:             venue.Name,
 		AmountIn:          formatEthWeiToEther(tx.Value()),
 		AmountOutMin:      formatERC20Decimals(trade.AmountOut, trade.Path[len(trade.Path)-1], client),
 		Path:              tradePathString,
@@ -351,27 +411,34 @@ func HandleSwapETHForExactTokens(tx *types.Transaction, client *ethclient.Client
 		OutputTokenSymbol: getTokenSymbol(trade.Path[len(trade.Path)-1], client),
 		OutputTokenName:   getTokenName(trade.Path[len(trade.Path)-1], client),
 	}
+	addUniswapTradeImpact(&final, venue, trade.Path, trade.AmountOut, tx.Value(), true, client)
 	fmt.Println()
-	fmt.Println(Red("New TX: Uniswap Trade"))
+	fmt.Println(Red("New TX: " + venue.Name + " Trade"))
 	fmt.Println("Hash: ", tx.Hash().Hex(), " Amount: ", final.AmountIn, "ETH", " For: ", final.OutputTokenSymbol)
 	if fullMode {
 		handleUniFinalTrade(tx, client, isStealth, final)
 	}
 }
 
-func HandleSwapTokensForExactEth(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+func HandleSwapTokensForExactEth(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, venue *dexVenue) {
 	var trade UniswapTokensForExactETHInput
 	method, _ := routerAbi.MethodById((swapTokensForExactETH)[:])
 	if err := method.Inputs.Unpack(
 		&trade, tx.Data()[4:],
 	); err != nil {
-		log.Fatal(err)
+		handleUndecodedRouterCall(tx, client, isStealth, fullMode, venue, err)
+		return
+	}
+	if len(trade.Path) < 2 {
+		handleUndecodedRouterCall(tx, client, isStealth, fullMode, venue, errShortSwapPath)
+		return
 	}
 	tradePathString := make([]string, len(trade.Path))
 	for i, s := range trade.Path {
 		tradePathString[i] = s.Hex()
 	}
 	final := UniswapTradeFinal{
+		Venue:             venue.Name,
 		AmountIn:          formatERC20Decimals(trade.AmountInMax, trade.Path[0], client),
 		AmountOutMin:      formatEthWeiToEther(trade.AmountOut),
 		Path:              tradePathString,
@@ -380,27 +447,34 @@ func HandleSwapTokensForExactEth(tx *types.Transaction, client *ethclient.Client
 		OutputTokenSymbol: "ETH",
 		OutputTokenName:   "Ether",
 	}
+	addUniswapTradeImpact(&final, venue, trade.Path, trade.AmountOut, trade.AmountInMax, true, client)
 	fmt.Println()
-	fmt.Println(Red("New TX: Uniswap Trade"))
+	fmt.Println(Red("New TX: " + venue.Name + " Trade"))
 	fmt.Println("Hash: ", tx.Hash().Hex(), " Amount: ", final.AmountIn, getTokenSymbol(trade.Path[0], client), " For: ", final.OutputTokenSymbol)
 	if fullMode {
 		handleUniFinalTrade(tx, client, isStealth, final)
 	}
 }
 
-func HandleSwapTokensForExactTokens(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+func HandleSwapTokensForExactTokens(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, venue *dexVenue) {
 	var trade UniswapTokensForExactTokensInput
 	method, _ := routerAbi.MethodById((swapTokensForExactTokens)[:])
 	if err := method.Inputs.Unpack(
 		&trade, tx.Data()[4:],
 	); err != nil {
-		log.Fatal(err)
+		handleUndecodedRouterCall(tx, client, isStealth, fullMode, venue, err)
+		return
+	}
+	if len(trade.Path) < 2 {
+		handleUndecodedRouterCall(tx, client, isStealth, fullMode, venue, errShortSwapPath)
+		return
 	}
 	tradePathString := make([]string, len(trade.Path))
 	for i, s := range trade.Path {
 		tradePathString[i] = s.Hex()
 	}
 	final := UniswapTradeFinal{
+		Venue:             venue.Name,
 		AmountIn:          formatERC20Decimals(trade.AmountInMax, trade.Path[0], client),
 		AmountOutMin:      formatERC20Decimals(trade.AmountOut, trade.Path[len(trade.Path)-1], client),
 		Path:              tradePathString,
@@ -409,27 +483,34 @@ func HandleSwapTokensForExactTokens(tx *types.Transaction, client *ethclient.Cli
 		OutputTokenSymbol: getTokenSymbol(trade.Path[len(trade.Path)-1], client),
 		OutputTokenName:   getTokenName(trade.Path[len(trade.Path)-1], client),
 	}
+	addUniswapTradeImpact(&final, venue, trade.Path, trade.AmountOut, trade.AmountInMax, true, client)
 	fmt.Println()
-	fmt.Println(Red("New TX: Uniswap Trade"))
+	fmt.Println(Red("New TX: " + venue.Name + " Trade"))
 	fmt.Println("Hash: ", tx.Hash().Hex(), " Amount: ", final.AmountIn, getTokenSymbol(trade.Path[0], client), " For: ", final.OutputTokenSymbol)
 	if fullMode {
 		handleUniFinalTrade(tx, client, isStealth, final)
 	}
 }
 
-func HandleSwapExactTokensForETHSupportingFeeOnTransferTokens(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+func HandleSwapExactTokensForETHSupportingFeeOnTransferTokens(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, venue *dexVenue) {
 	var trade UniswapExactTokensForETHSupportingFeeOnTransferTokensInput
 	method, _ := routerAbi.MethodById((swapExactTokensForETHSupportingFeeOnTransferTokens)[:])
 	if err := method.Inputs.Unpack(
 		&trade, tx.Data()[4:],
 	); err != nil {
-		log.Fatal(err)
+		handleUndecodedRouterCall(tx, client, isStealth, fullMode, venue, err)
+		return
+	}
+	if len(trade.Path) < 2 {
+		handleUndecodedRouterCall(tx, client, isStealth, fullMode, venue, errShortSwapPath)
+		return
 	}
 	tradePathString := make([]string, len(trade.Path))
 	for i, s := range trade.Path {
 		tradePathString[i] = s.Hex()
 	}
 	final := UniswapTradeFinal{
+		Venue:             venue.Name,
 		AmountIn:          formatERC20Decimals(trade.AmountIn, trade.Path[0], client),
 		AmountOutMin:      formatEthWeiToEther(trade.AmountOutMin),
 		Path:              tradePathString,
@@ -438,27 +519,34 @@ func HandleSwapExactTokensForETHSupportingFeeOnTransferTokens(tx *types.Transact
 		OutputTokenSymbol: "ETH",
 		OutputTokenName:   "Ether",
 	}
+	addUniswapTradeImpact(&final, venue, trade.Path, trade.AmountIn, trade.AmountOutMin, false, client)
 	fmt.Println()
-	fmt.Println(Red("New TX: Uniswap Trade"))
+	fmt.Println(Red("New TX: " + venue.Name + " Trade"))
 	fmt.Println("Hash: ", tx.Hash().Hex(), " Amount: ", final.AmountIn, getTokenSymbol(trade.Path[0], client), " For: ", final.OutputTokenSymbol)
 	if fullMode {
 		handleUniFinalTrade(tx, client, isStealth, final)
 	}
 }
 
-func HandleSwapExactTokensForTokensSupportingFeeOnTransferTokens(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+func HandleSwapExactTokensForTokensSupportingFeeOnTransferTokens(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, venue *dexVenue) {
 	var trade UniswapExactTokensForTokensSupportingFeeOnTransferTokensInput
 	method, _ := routerAbi.MethodById((swapExactTokensForTokensSupportingFeeOnTransferTokens)[:])
 	if err := method.Inputs.Unpack(
 		&trade, tx.Data()[4:],
 	); err != nil {
-		log.Fatal(err)
+		handleUndecodedRouterCall(tx, client, isStealth, fullMode, venue, err)
+		return
+	}
+	if len(trade.Path) < 2 {
+		handleUndecodedRouterCall(tx, client, isStealth, fullMode, venue, errShortSwapPath)
+		return
 	}
 	tradePathString := make([]string, len(trade.Path))
 	for i, s := range trade.Path {
 		tradePathString[i] = s.Hex()
 	}
 	final := UniswapTradeFinal{
+		Venue:             venue.Name,
 		AmountIn:          formatERC20Decimals(trade.AmountIn, trade.Path[0], client),
 		AmountOutMin:      formatERC20Decimals(trade.AmountOutMin, trade.Path[len(trade.Path)-1], client),
 		Path:              tradePathString,
@@ -467,27 +555,34 @@ func HandleSwapExactTokensForTokensSupportingFeeOnTransferTokens(tx *types.Trans
 		OutputTokenSymbol: getTokenSymbol(trade.Path[len(trade.Path)-1], client),
 		OutputTokenName:   getTokenName(trade.Path[len(trade.Path)-1], client),
 	}
+	addUniswapTradeImpact(&final, venue, trade.Path, trade.AmountIn, trade.AmountOutMin, false, client)
 	fmt.Println()
-	fmt.Println(Red("New TX: Uniswap Trade"))
+	fmt.Println(Red("New TX: " + venue.Name + " Trade"))
 	fmt.Println("Hash: ", tx.Hash().Hex(), " Amount: ", final.AmountIn, getTokenSymbol(trade.Path[0], client), " For: ", final.OutputTokenSymbol)
 	if fullMode {
 		handleUniFinalTrade(tx, client, isStealth, final)
 	}
 }
 
-func HandleSwapExactETHForTokensSupportingFeeOnTransferTokens(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+func HandleSwapExactETHForTokensSupportingFeeOnTransferTokens(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, venue *dexVenue) {
 	var trade UniswapExactETHForTokensSupportingFeeOnTransferTokensInput
 	method, _ := routerAbi.MethodById((swapExactETHForTokensSupportingFeeOnTransferTokens)[:])
 	if err := method.Inputs.Unpack(
 		&trade, tx.Data()[4:],
 	); err != nil {
-		log.Fatal(err)
+		handleUndecodedRouterCall(tx, client, isStealth, fullMode, venue, err)
+		return
+	}
+	if len(trade.Path) < 2 {
+		handleUndecodedRouterCall(tx, client, isStealth, fullMode, venue, errShortSwapPath)
+		return
 	}
 	tradePathString := make([]string, len(trade.Path))
 	for i, s := range trade.Path {
 		tradePathString[i] = s.Hex()
 	}
 	final := UniswapTradeFinal{
+		Venue:             venue.Name,
 		AmountIn:          formatEthWeiToEther(tx.Value()),
 		AmountOutMin:      formatERC20Decimals(trade.AmountOutMin, trade.Path[len(trade.Path)-1], client),
 		Path:              tradePathString,
@@ -496,8 +591,9 @@ func HandleSwapExactETHForTokensSupportingFeeOnTransferTokens(tx *types.Transact
 		OutputTokenSymbol: getTokenSymbol(trade.Path[len(trade.Path)-1], client),
 		OutputTokenName:   getTokenName(trade.Path[len(trade.Path)-1], client),
 	}
+	addUniswapTradeImpact(&final, venue, trade.Path, tx.Value(), trade.AmountOutMin, false, client)
 	fmt.Println()
-	fmt.Println(Red("New TX: Uniswap Trade"))
+	fmt.Println(Red("New TX: " + venue.Name + " Trade"))
 	fmt.Println("Hash: ", tx.Hash().Hex(), " Amount: ", final.AmountIn, getTokenSymbol(trade.Path[0], client), " For: ", final.OutputTokenSymbol)
 	if fullMode {
 		handleUniFinalTrade(tx, client, isStealth, final)
@@ -506,16 +602,18 @@ func HandleSwapExactETHForTokensSupportingFeeOnTransferTokens(tx *types.Transact
 
 // Functions to add liquidity
 
-func HandleAddLiquidity(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+func HandleAddLiquidity(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, venue *dexVenue) {
 	var unpacked UniswapAddLiquidityInput
 	method, _ := routerAbi.MethodById((addLiquidity)[:])
 	if err := method.Inputs.Unpack(
 		&unpacked, tx.Data()[4:],
 	); err != nil {
-		log.Fatal(err)
+		handleUndecodedRouterCall(tx, client, isStealth, fullMode, venue, err)
+		return
 	}
 
 	final := UniswapAddLiquidityFinalInput{
+		Venue:                    venue.Name,
 		TokenAAddress:            unpacked.TokenA.Hex(),
 		TokenBAddress:            unpacked.TokenB.Hex(),
 		LiquidityProviderAddress: unpacked.To.Hex(),
@@ -526,23 +624,25 @@ func HandleAddLiquidity(tx *types.Transaction, client *ethclient.Client, isSteal
 		AmountBDesired:           formatERC20Decimals(unpacked.AmountBDesired, unpacked.TokenB, client),
 	}
 	fmt.Println()
-	fmt.Println(Red("New TX: Uniswap Add Liquidity"))
+	fmt.Println(Red("New TX: " + venue.Name + " Add Liquidity"))
 	fmt.Println("Hash: ", tx.Hash().Hex())
 	if fullMode {
 		handleUniAddLiq(tx, client, isStealth, final)
 	}
 }
 
-func HandleAddLiquidityETH(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+func HandleAddLiquidityETH(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, venue *dexVenue) {
 	var addLiquidity UniswapAddLiquidityETHInput
 	method, _ := routerAbi.MethodById((addLiquidityETH)[:])
 	if err := method.Inputs.Unpack(
 		&addLiquidity, tx.Data()[4:],
 	); err != nil {
-		log.Fatal(err)
+		handleUndecodedRouterCall(tx, client, isStealth, fullMode, venue, err)
+		return
 	}
 
 	final := UniswapAddLiquidityETHFinalInput{
+		Venue:                    venue.Name,
 		TokenAddress:             addLiquidity.Token.Hex(),
 		LiquidityProviderAddress: addLiquidity.To.Hex(),
 		Deadline:                 addLiquidity.Deadline.Int64(),
@@ -550,7 +650,7 @@ func HandleAddLiquidityETH(tx *types.Transaction, client *ethclient.Client, isSt
 		AmountTokenMin:           formatERC20Decimals(addLiquidity.AmountTokenMin, addLiquidity.Token, client),
 		AmountEthMin:             formatEthWeiToEther(addLiquidity.AmountETHMin),
 	}
-	fmt.Println(Red("New TX: Uniswap Add Liquidity"))
+	fmt.Println(Red("New TX: " + venue.Name + " Add Liquidity"))
 	fmt.Println("Hash: ", tx.Hash().Hex())
 	if fullMode {
 		handleUniAddETHLiq(tx, client, isStealth, final)
@@ -559,16 +659,18 @@ func HandleAddLiquidityETH(tx *types.Transaction, client *ethclient.Client, isSt
 
 // Functions to remove liquidity
 
-func HandleRemoveLiquidityETHWithPermit(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+func HandleRemoveLiquidityETHWithPermit(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, venue *dexVenue) {
 	var removeLiquidity UniswapRemoveLiquidityETHWithPermit
 	method, _ := routerAbi.MethodById((removeLiquidityETH)[:])
 	if err := method.Inputs.Unpack(
 		&removeLiquidity, tx.Data()[4:],
 	); err != nil {
-		log.Fatal(err)
+		handleUndecodedRouterCall(tx, client, isStealth, fullMode, venue, err)
+		return
 	}
 
 	final := UniswapRemoveLiquidityETHFinalInput{
+		Venue:                    venue.Name,
 		TokenAddress:             removeLiquidity.Token.Hex(),
 		LiquidityProviderAddress: removeLiquidity.To.Hex(),
 		Deadline:                 removeLiquidity.Deadline.Int64(),
@@ -577,7 +679,7 @@ func HandleRemoveLiquidityETHWithPermit(tx *types.Transaction, client *ethclient
 		AmountETHMin:             formatEthWeiToEther(removeLiquidity.AmountETHMin),
 	}
 	fmt.Println()
-	fmt.Println(Red("New TX: Uniswap Remove Liquidity"))
+	fmt.Println(Red("New TX: " + venue.Name + " Remove Liquidity"))
 	fmt.Println("Hash: ", tx.Hash().Hex())
 	if fullMode {
 		handleUniRemoveETHLiq(tx, client, isStealth, final)
@@ -585,16 +687,18 @@ func HandleRemoveLiquidityETHWithPermit(tx *types.Transaction, client *ethclient
 
 }
 
-func HandleRemoveLiquidityETH(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+func HandleRemoveLiquidityETH(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, venue *dexVenue) {
 	var unpack UniswapRemoveLiquidityETHInput
 	method, _ := routerAbi.MethodById((removeLiquidityETH)[:])
 	if err := method.Inputs.Unpack(
 		&unpack, tx.Data()[4:],
 	); err != nil {
-		log.Fatal(err)
+		handleUndecodedRouterCall(tx, client, isStealth, fullMode, venue, err)
+		return
 	}
 
 	final := UniswapRemoveLiquidityETHFinalInput{
+		Venue:                    venue.Name,
 		TokenAddress:             unpack.Token.Hex(),
 		LiquidityProviderAddress: unpack.To.Hex(),
 		Deadline:                 unpack.Deadline.Int64(),
@@ -603,23 +707,25 @@ func HandleRemoveLiquidityETH(tx *types.Transaction, client *ethclient.Client, i
 		LPTokenAmount:            formatEthWeiToEther(unpack.Liquidity),
 	}
 	fmt.Println()
-	fmt.Println(Red("New TX: Uniswap Remove Liquidity"))
+	fmt.Println(Red("New TX: " + venue.Name + " Remove Liquidity"))
 	fmt.Println("Hash: ", tx.Hash().Hex())
 	if fullMode {
 		handleUniRemoveETHLiq(tx, client, isStealth, final)
 	}
 }
 
-func HandleRemoveLiquidityWithPermit(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+func HandleRemoveLiquidityWithPermit(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, venue *dexVenue) {
 	var unpack UniswapRemoveLiquidityWithPermitInput
 	method, _ := routerAbi.MethodById((removeLiquidityWithPermit)[:])
 	if err := method.Inputs.Unpack(
 		&unpack, tx.Data()[4:],
 	); err != nil {
-		log.Fatal(err)
+		handleUndecodedRouterCall(tx, client, isStealth, fullMode, venue, err)
+		return
 	}
 
 	final := UniswapRemoveLiquidityFinalInput{
+		Venue:      venue.Name,
 		TokenA:     unpack.TokenA.Hex(),
 		TokenB:     unpack.TokenB.Hex(),
 		AmountAMin: formatERC20Decimals(unpack.AmountAMin, unpack.TokenA, client),
@@ -627,23 +733,25 @@ func HandleRemoveLiquidityWithPermit(tx *types.Transaction, client *ethclient.Cl
 		Deadline:   unpack.Deadline.Int64(),
 	}
 	fmt.Println()
-	fmt.Println(Red("New TX: Uniswap Remove Liquidity"))
+	fmt.Println(Red("New TX: " + venue.Name + " Remove Liquidity"))
 	fmt.Println("Hash: ", tx.Hash().Hex())
 	if fullMode {
 		handleUniRemoveLiq(tx, client, isStealth, final)
 	}
 }
 
-func HandleRemoveLiquidity(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+func HandleRemoveLiquidity(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, venue *dexVenue) {
 	var unpack UniswapRemoveLiquidityInput
 	method, _ := routerAbi.MethodById((removeLiquidity)[:])
 	if err := method.Inputs.Unpack(
 		&unpack, tx.Data()[4:],
 	); err != nil {
-		log.Fatal(err)
+		handleUndecodedRouterCall(tx, client, isStealth, fullMode, venue, err)
+		return
 	}
 
 	final := UniswapRemoveLiquidityFinalInput{
+		Venue:      venue.Name,
 		TokenA:     unpack.TokenA.Hex(),
 		TokenB:     unpack.TokenB.Hex(),
 		AmountAMin: formatERC20Decimals(unpack.AmountAMin, unpack.TokenA, client),
@@ -651,7 +759,7 @@ func HandleRemoveLiquidity(tx *types.Transaction, client *ethclient.Client, isSt
 		Deadline:   unpack.Deadline.Int64(),
 	}
 	fmt.Println()
-	fmt.Println(Red("New TX: Uniswap Remove Liquidity"))
+	fmt.Println(Red("New TX: " + venue.Name + " Remove Liquidity"))
 	fmt.Println("Hash: ", tx.Hash().Hex())
 	if fullMode {
 		handleUniRemoveLiq(tx, client, isStealth, final)
@@ -659,16 +767,18 @@ func HandleRemoveLiquidity(tx *types.Transaction, client *ethclient.Client, isSt
 
 }
 
-func HandleRemoveLiquidityETHWithPermitSupportingFeeOnTransferTokens(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+func HandleRemoveLiquidityETHWithPermitSupportingFeeOnTransferTokens(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, venue *dexVenue) {
 	var unpack UniswapRemoveLiquidityETHWithPermitSupportingFeeOnTransferTokensInput
 	method, _ := routerAbi.MethodById((removeLiquidityETH)[:])
 	if err := method.Inputs.Unpack(
 		&unpack, tx.Data()[4:],
 	); err != nil {
-		log.Fatal(err)
+		handleUndecodedRouterCall(tx, client, isStealth, fullMode, venue, err)
+		return
 	}
 
 	final := UniswapRemoveLiquidityETHFinalInput{
+		Venue:                    venue.Name,
 		TokenAddress:             unpack.Token.Hex(),
 		LiquidityProviderAddress: unpack.To.Hex(),
 		Deadline:                 unpack.Deadline.Int64(),
@@ -677,23 +787,25 @@ func HandleRemoveLiquidityETHWithPermitSupportingFeeOnTransferTokens(tx *types.T
 		LPTokenAmount:            formatEthWeiToEther(unpack.Liquidity), // UNI LP tokens have 18 decimals too
 	}
 	fmt.Println()
-	fmt.Println(Red("New TX: Uniswap Remove Liquidity"))
+	fmt.Println(Red("New TX: " + venue.Name + " Remove Liquidity"))
 	fmt.Println("Hash: ", tx.Hash().Hex())
 	if fullMode {
 		handleUniRemoveETHLiq(tx, client, isStealth, final)
 	}
 }
 
-func HandleRemoveLiquidityETHSupportingFeeOnTransferTokens(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+func HandleRemoveLiquidityETHSupportingFeeOnTransferTokens(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, venue *dexVenue) {
 	var unpack UniswapRemoveLiquidityETHSupportingFeeOnTransferTokens
 	method, _ := routerAbi.MethodById((removeLiquidityETHSupportingFeeOnTransferTokens)[:])
 	if err := method.Inputs.Unpack(
 		&unpack, tx.Data()[4:],
 	); err != nil {
-		log.Fatal(err)
+		handleUndecodedRouterCall(tx, client, isStealth, fullMode, venue, err)
+		return
 	}
 
 	final := UniswapRemoveLiquidityETHFinalInput{
+		Venue:                    venue.Name,
 		TokenAddress:             unpack.Token.Hex(),
 		LiquidityProviderAddress: unpack.To.Hex(),
 		Deadline:                 unpack.Deadline.Int64(),
@@ -702,7 +814,7 @@ func HandleRemoveLiquidityETHSupportingFeeOnTransferTokens(tx *types.Transaction
 		LPTokenAmount:            formatEthWeiToEther(unpack.Liquidity), // UNI LP tokens have 18 decimals too
 	}
 	fmt.Println()
-	fmt.Println(Red("New TX: Uniswap Remove Liquidity"))
+	fmt.Println(Red("New TX: " + venue.Name + " Remove Liquidity"))
 	fmt.Println("Hash: ", tx.Hash().Hex())
 	if fullMode {
 		handleUniRemoveETHLiq(tx, client, isStealth, final)
@@ -710,45 +822,46 @@ func HandleRemoveLiquidityETHSupportingFeeOnTransferTokens(tx *types.Transaction
 }
 
 // Core method that determines the kind of uniswap trade the tx is
-func handleUniswapTrade(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+// Works for every V2 fork in the venue registry since they share the router ABI
+func handleUniswapTrade(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool, venue *dexVenue) {
 	// Iterate through each function (ranked by popularity, https://bloxy.info/address/0x7a250d5630B4cF539739dF2C5dAcb4c659F2488D)
 	// Store data in the format we need
 	txFunctionHash := [4]byte{}
 	copy(txFunctionHash[:], tx.Data()[:4])
 	switch txFunctionHash {
 	case swapExactETHForTokens:
-		HandleSwapExactETHForTokens(tx, client, isStealth, fullMode)
+		HandleSwapExactETHForTokens(tx, client, isStealth, fullMode, venue)
 	case swapExactTokensForETH:
-		HandleSwapExactTokensForETH(tx, client, isStealth, fullMode)
+		HandleSwapExactTokensForETH(tx, client, isStealth, fullMode, venue)
 	case swapExactTokensForTokens:
-		HandleSwapExactTokensForTokens(tx, client, isStealth, fullMode)
+		HandleSwapExactTokensForTokens(tx, client, isStealth, fullMode, venue)
 	case swapETHForExactTokens:
-		HandleSwapETHForExactTokens(tx, client, isStealth, fullMode)
+		HandleSwapETHForExactTokens(tx, client, isStealth, fullMode, venue)
 	case addLiquidityETH: // ADD LIQ
-		HandleAddLiquidityETH(tx, client, isStealth, fullMode)
+		HandleAddLiquidityETH(tx, client, isStealth, fullMode, venue)
 	case swapTokensForExactETH:
-		HandleSwapTokensForExactEth(tx, client, isStealth, fullMode)
+		HandleSwapTokensForExactEth(tx, client, isStealth, fullMode, venue)
 	case swapTokensForExactTokens:
-		HandleSwapTokensForExactTokens(tx, client, isStealth, fullMode)
+		HandleSwapTokensForExactTokens(tx, client, isStealth, fullMode, venue)
 	case swapExactTokensForETHSupportingFeeOnTransferTokens:
-		HandleSwapExactTokensForETHSupportingFeeOnTransferTokens(tx, client, isStealth, fullMode)
+		HandleSwapExactTokensForETHSupportingFeeOnTransferTokens(tx, client, isStealth, fullMode, venue)
 	case removeLiquidityETHWithPermit: // REMOVE LIQ
-		HandleRemoveLiquidityETHWithPermit(tx, client, isStealth, fullMode)
+		HandleRemoveLiquidityETHWithPermit(tx, client, isStealth, fullMode, venue)
 	case addLiquidity: // ADD LIQ
-		HandleAddLiquidity(tx, client, isStealth, fullMode)
+		HandleAddLiquidity(tx, client, isStealth, fullMode, venue)
 	case swapExactTokensForTokensSupportingFeeOnTransferTokens:
-		HandleSwapExactTokensForTokensSupportingFeeOnTransferTokens(tx, client, isStealth, fullMode)
+		HandleSwapExactTokensForTokensSupportingFeeOnTransferTokens(tx, client, isStealth, fullMode, venue)
 	case removeLiquidityETH: // REMOVE LIQ
-		HandleRemoveLiquidityETH(tx, client, isStealth, fullMode)
+		HandleRemoveLiquidityETH(tx, client, isStealth, fullMode, venue)
 	case removeLiquidityWithPermit: // REMOVE LIQ
-		HandleRemoveLiquidityWithPermit(tx, client, isStealth, fullMode)
+		HandleRemoveLiquidityWithPermit(tx, client, isStealth, fullMode, venue)
 	case removeLiquidity: // REMOVE LIQ
-		HandleRemoveLiquidity(tx, client, isStealth, fullMode)
+		HandleRemoveLiquidity(tx, client, isStealth, fullMode, venue)
 	case swapExactETHForTokensSupportingFeeOnTransferTokens:
-		HandleSwapExactETHForTokensSupportingFeeOnTransferTokens(tx, client, isStealth, fullMode)
+		HandleSwapExactETHForTokensSupportingFeeOnTransferTokens(tx, client, isStealth, fullMode, venue)
 	case removeLiquidityETHWithPermitSupportingFeeOnTransferTokens: // REMOVE LIQ
-		HandleRemoveLiquidityETHWithPermitSupportingFeeOnTransferTokens(tx, client, isStealth, fullMode)
+		HandleRemoveLiquidityETHWithPermitSupportingFeeOnTransferTokens(tx, client, isStealth, fullMode, venue)
 	case removeLiquidityETHSupportingFeeOnTransferTokens: // REMOVE LIQ
-		HandleRemoveLiquidityETHSupportingFeeOnTransferTokens(tx, client, isStealth, fullMode)
+		HandleRemoveLiquidityETHSupportingFeeOnTransferTokens(tx, client, isStealth, fullMode, venue)
 	}
 }
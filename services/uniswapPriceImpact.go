@@ -0,0 +1,237 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/taarushv/helios/contracts/uniswap"
+)
+
+// What a pending V2 trade will actually do at the current reserves
+// Pairs are derived the same way the router does it (UniswapV2Library.pairFor), so no factory lookups per hop
+// Venues without a known init code hash (see dexVenues.go) fall back to factory.getPair
+
+// Stop searching for the max front-run after this many halvings (plenty for 112 bit reserves)
+const uniMaxFrontrunSearchSteps = 128
+
+type uniswapReserves struct {
+	Pair       common.Address
+	ReserveIn  *big.Int
+	ReserveOut *big.Int
+}
+
+// Same ordering as UniswapV2Library.sortTokens
+func sortUniswapTokens(tokenA common.Address, tokenB common.Address) (common.Address, common.Address) {
+	if bytes.Compare(tokenA.Bytes(), tokenB.Bytes()) < 0 {
+		return tokenA, tokenB
+	}
+	return tokenB, tokenA
+}
+
+// CREATE2 address of the pair, keccak256(0xff ++ factory ++ keccak256(token0 ++ token1) ++ initCodeHash)
+func getUniswapPairAddress(factory common.Address, initCodeHash common.Hash, tokenA common.Address, tokenB common.Address) common.Address {
+	token0, token1 := sortUniswapTokens(tokenA, tokenB)
+	salt := crypto.Keccak256Hash(token0.Bytes(), token1.Bytes())
+	return crypto.CreateAddress2(factory, salt, initCodeHash.Bytes())
+}
+
+// Pair address on `venue`, derived locally when we know the init code hash
+func getVenuePairAddress(venue *dexVenue, tokenA common.Address, tokenB common.Address, client *ethclient.Client) (common.Address, error) {
+	factory := common.HexToAddress(venue.Factory)
+	if venue.InitCodeHash != "" {
+		return getUniswapPairAddress(factory, common.HexToHash(venue.InitCodeHash), tokenA, tokenB), nil
+	}
+	factoryInstance, err := uniswap.NewUniswapFactory(factory, client)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return factoryInstance.GetPair(nil, tokenA, tokenB)
+}
+
+// Reserves of every hop in `path`, oriented in the direction of the trade
+func getUniswapPathReserves(venue *dexVenue, path []common.Address, client *ethclient.Client) ([]*uniswapReserves, error) {
+	if len(path) < 2 {
+		return nil, fmt.Errorf("invalid path length %d", len(path))
+	}
+	hops := make([]*uniswapReserves, len(path)-1)
+	for i := 0; i < len(path)-1; i++ {
+		pair, err := getVenuePairAddress(venue, path[i], path[i+1], client)
+		if err != nil {
+			return nil, err
+		}
+		pairInstance, err := uniswap.NewUniswapPair(pair, client)
+		if err != nil {
+			return nil, err
+		}
+		reserves, err := pairInstance.GetReserves(nil)
+		if err != nil {
+			return nil, fmt.Errorf("no %s pair for %s/%s: %v", venue.Name, path[i].Hex(), path[i+1].Hex(), err)
+		}
+		hop := &uniswapReserves{Pair: pair, ReserveIn: reserves.Reserve0, ReserveOut: reserves.Reserve1}
+		if token0, _ := sortUniswapTokens(path[i], path[i+1]); token0 != path[i] {
+			hop.ReserveIn, hop.ReserveOut = reserves.Reserve1, reserves.Reserve0
+		}
+		hops[i] = hop
+	}
+	return hops, nil
+}
+
+// UniswapV2Library.getAmountOut (0.3% fee)
+func getUniswapAmountOut(amountIn *big.Int, reserveIn *big.Int, reserveOut *big.Int) *big.Int {
+	if amountIn.Sign() <= 0 || reserveIn.Sign() <= 0 || reserveOut.Sign() <= 0 {
+		return new(big.Int)
+	}
+	amountInWithFee := new(big.Int).Mul(amountIn, big.NewInt(997))
+	numerator := new(big.Int).Mul(amountInWithFee, reserveOut)
+	denominator := new(big.Int).Mul(reserveIn, big.NewInt(1000))
+	denominator.Add(denominator, amountInWithFee)
+	return numerator.Div(numerator, denominator)
+}
+
+// UniswapV2Library.getAmountIn, nil if the pool doesn't have `amountOut` to give
+func getUniswapAmountIn(amountOut *big.Int, reserveIn *big.Int, reserveOut *big.Int) *big.Int {
+	if amountOut.Sign() <= 0 || reserveIn.Sign() <= 0 || amountOut.Cmp(reserveOut) >= 0 {
+		return nil
+	}
+	numerator := new(big.Int).Mul(reserveIn, amountOut)
+	numerator.Mul(numerator, big.NewInt(1000))
+	denominator := new(big.Int).Sub(reserveOut, amountOut)
+	denominator.Mul(denominator, big.NewInt(997))
+	amountIn := numerator.Div(numerator, denominator)
+	return amountIn.Add(amountIn, big.NewInt(1))
+}
+
+// Run `amountIn` through the hops, updating the reserves in place (like the trade was mined) when `apply` is set
+func swapUniswapExactIn(hops []*uniswapReserves, amountIn *big.Int, apply bool) *big.Int {
+	amount := amountIn
+	for _, hop := range hops {
+		amountOut := getUniswapAmountOut(amount, hop.ReserveIn, hop.ReserveOut)
+		if apply {
+			hop.ReserveIn = new(big.Int).Add(hop.ReserveIn, amount)
+			hop.ReserveOut = new(big.Int).Sub(hop.ReserveOut, amountOut)
+		}
+		amount = amountOut
+	}
+	return amount
+}
+
+// Input needed to get `amountOut` out of the last hop, nil if it can't be filled
+func quoteUniswapExactOut(hops []*uniswapReserves, amountOut *big.Int) *big.Int {
+	amount := amountOut
+	for i := len(hops) - 1; i >= 0; i-- {
+		amount = getUniswapAmountIn(amount, hops[i].ReserveIn, hops[i].ReserveOut)
+		if amount == nil {
+			return nil
+		}
+	}
+	return amount
+}
+
+// Output at the mid price of every hop (what the trade would get with infinite liquidity and no fee)
+func quoteUniswapMidPrice(hops []*uniswapReserves, amountIn *big.Int) *big.Float {
+	amount := new(big.Float).SetInt(amountIn)
+	for _, hop := range hops {
+		if hop.ReserveIn.Sign() == 0 {
+			return new(big.Float)
+		}
+		amount.Mul(amount, new(big.Float).SetInt(hop.ReserveOut))
+		amount.Quo(amount, new(big.Float).SetInt(hop.ReserveIn))
+	}
+	return amount
+}
+
+func copyUniswapReserves(hops []*uniswapReserves) []*uniswapReserves {
+	copied := make([]*uniswapReserves, len(hops))
+	for i, hop := range hops {
+		copied[i] = &uniswapReserves{Pair: hop.Pair, ReserveIn: hop.ReserveIn, ReserveOut: hop.ReserveOut}
+	}
+	return copied
+}
+
+// Checks if the victim trade still goes through after someone front-runs it with `frontrun` along the same path
+func victimSurvivesFrontrun(hops []*uniswapReserves, frontrun *big.Int, amount *big.Int, limit *big.Int, exactOutput bool) bool {
+	after := copyUniswapReserves(hops)
+	swapUniswapExactIn(after, frontrun, true)
+	if exactOutput {
+		amountIn := quoteUniswapExactOut(after, amount)
+		return amountIn != nil && amountIn.Cmp(limit) <= 0
+	}
+	return swapUniswapExactIn(after, amount, false).Cmp(limit) >= 0
+}
+
+// Largest front-run (in the input token) that still leaves the victim within their slippage limit, found by binary search
+func getUniswapMaxFrontrun(hops []*uniswapReserves, amount *big.Int, limit *big.Int, exactOutput bool) *big.Int {
+	if !victimSurvivesFrontrun(hops, new(big.Int), amount, limit, exactOutput) {
+		return new(big.Int)
+	}
+	// Anything past the first pool's input reserve moves the price > 4x, way beyond any sane slippage setting
+	low, high := new(big.Int), new(big.Int).Set(hops[0].ReserveIn)
+	if victimSurvivesFrontrun(hops, high, amount, limit, exactOutput) {
+		return high
+	}
+	one := big.NewInt(1)
+	for i := 0; i < uniMaxFrontrunSearchSteps && new(big.Int).Sub(high, low).Cmp(one) > 0; i++ {
+		middle := new(big.Int).Add(low, high)
+		middle.Rsh(middle, 1)
+		if victimSurvivesFrontrun(hops, middle, amount, limit, exactOutput) {
+			low = middle
+		} else {
+			high = middle
+		}
+	}
+	return low
+}
+
+func percentOf(part *big.Float, whole *big.Float) float64 {
+	if whole.Sign() == 0 {
+		return 0
+	}
+	result := new(big.Float).Quo(part, whole)
+	final, _ := result.Mul(result, big.NewFloat(100)).Float64()
+	return final
+}
+
+// Fill in the expected amounts, price impact, implied slippage and max front-run on a trade
+// Exact input trades pass (amountIn, amountOutMin), exact output trades pass (amountOut, amountInMax)
+// Fee-on-transfer tokens get less than quoted here, the router only checks what actually arrives
+func addUniswapTradeImpact(final *UniswapTradeFinal, venue *dexVenue, path []common.Address, amount *big.Int, limit *big.Int, exactOutput bool, client *ethclient.Client) {
+	hops, err := getUniswapPathReserves(venue, path, client)
+	if err != nil {
+		fmt.Println("Error fetching uniswap reserves:", err)
+		return
+	}
+	final.Pairs = make([]string, len(hops))
+	for i, hop := range hops {
+		final.Pairs[i] = hop.Pair.Hex()
+	}
+	tokenIn, tokenOut := path[0], path[len(path)-1]
+	amountIn, amountOut := amount, new(big.Int)
+	if exactOutput {
+		amountOut = amount
+		amountIn = quoteUniswapExactOut(hops, amountOut)
+		if amountIn == nil {
+			// Not enough liquidity, the router reverts
+			final.ExceedsSlippage = true
+			return
+		}
+		final.ExceedsSlippage = amountIn.Cmp(limit) > 0
+		slippage := new(big.Float).SetInt(new(big.Int).Sub(limit, amountIn))
+		final.SlippageTolerance = percentOf(slippage, new(big.Float).SetInt(amountIn))
+	} else {
+		amountOut = swapUniswapExactIn(hops, amountIn, false)
+		final.ExceedsSlippage = amountOut.Cmp(limit) < 0
+		slippage := new(big.Float).SetInt(new(big.Int).Sub(amountOut, limit))
+		final.SlippageTolerance = percentOf(slippage, new(big.Float).SetInt(amountOut))
+	}
+	final.ExpectedAmountIn = formatERC20Decimals(amountIn, tokenIn, client)
+	final.ExpectedAmountOut = formatERC20Decimals(amountOut, tokenOut, client)
+	midPriceOut := quoteUniswapMidPrice(hops, amountIn)
+	final.PriceImpact = percentOf(new(big.Float).Sub(midPriceOut, new(big.Float).SetInt(amountOut)), midPriceOut)
+	if !final.ExceedsSlippage {
+		final.MaxFrontrunAmountIn = formatERC20Decimals(getUniswapMaxFrontrun(hops, amount, limit, exactOutput), tokenIn, client)
+	}
+}
@@ -0,0 +1,287 @@
+package services
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	. "github.com/logrusorgru/aurora"
+	"github.com/taarushv/helios/contracts/uniswapV3"
+)
+
+// Uniswap V3 routers, trades end up in the same "uniswapTrade" documents as V2 (venue "Uniswap V3")
+// SwapRouter02 dropped the deadline from the swap params (it moved to multicall) so it gets its own ABI
+var swapRouterAbi, _ = abi.JSON(strings.NewReader(uniswapV3.SwapRouterABI))
+var swapRouter02Abi, _ = abi.JSON(strings.NewReader(uniswapV3.SwapRouter02ABI))
+
+const uniV3VenueName = "Uniswap V3"
+
+// SwapRouter and SwapRouter02, selectors don't overlap so both are decoded the same way
+var uniV3Routers = map[common.Address]bool{
+	common.HexToAddress("0xE592427A0AEce92De3Edee1F18E0157C05861564"): true,
+	common.HexToAddress("0x68b3465833fb72A70ecDF485E0e4C7bD8665Fc45"): true,
+}
+
+var wethAddress = common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+
+// SwapRouter
+var uniV3ExactInputSingle = []byte{0x41, 0x4b, 0xf3, 0x89}
+var uniV3ExactInput = []byte{0xc0, 0x4b, 0x8d, 0x59}
+var uniV3ExactOutputSingle = []byte{0xdb, 0x3e, 0x21, 0x98}
+var uniV3ExactOutput = []byte{0xf2, 0x8c, 0x04, 0x98}
+
+// SwapRouter02
+var uniV3ExactInputSingle02 = []byte{0x04, 0xe4, 0x5a, 0xaf}
+var uniV3ExactInput02 = []byte{0xb8, 0x58, 0x18, 0x3f}
+var uniV3ExactOutputSingle02 = []byte{0x50, 0x23, 0xb4, 0xdf}
+var uniV3ExactOutput02 = []byte{0x09, 0xb8, 0x13, 0x46}
+
+// Batching + ETH handling (both routers)
+var uniV3Multicall = []byte{0xac, 0x96, 0x50, 0xd8}                  // multicall(bytes[])
+var uniV3MulticallDeadline = []byte{0x5a, 0xe4, 0x01, 0xdc}          // multicall(uint256,bytes[])
+var uniV3MulticallPreviousBlockhash = []byte{0x1f, 0x04, 0x64, 0xd1} // multicall(bytes32,bytes[])
+var uniV3UnwrapWETH9 = []byte{0x49, 0x40, 0x4b, 0x7c}                // unwrapWETH9(uint256,address)
+var uniV3UnwrapWETH902 = []byte{0x49, 0x61, 0x69, 0x97}              // unwrapWETH9(uint256)
+
+// SwapRouter02 recipient placeholders (Constants.MSG_SENDER/ADDRESS_THIS)
+var uniV3MsgSender = common.HexToAddress("0x0000000000000000000000000000000000000001")
+var uniV3AddressThis = common.HexToAddress("0x0000000000000000000000000000000000000002")
+
+// Normalised view of any of the swap calls
+type uniswapV3Swap struct {
+	Path              []common.Address // Always in trade direction (tokenIn first)
+	Fees              []uint32         // Fee tier of every hop, in hundredths of a bip (500 = 0.05%)
+	Recipient         common.Address
+	Deadline          *big.Int
+	ExactOutput       bool
+	Amount            *big.Int // amountIn, or amountOut for exact output swaps
+	Limit             *big.Int // amountOutMinimum, or amountInMaximum for exact output swaps
+	SqrtPriceLimitX96 *big.Int // Single hop swaps only, 0 means no limit
+}
+
+func isUniswapV3Router(tx *types.Transaction) bool {
+	return uniV3Routers[*tx.To()]
+}
+
+// Packed path: token (20 bytes) | fee (3 bytes) | token | fee | token ...
+// Exact output paths are encoded backwards (tokenOut first), flip them so every document reads in trade direction
+func decodeUniswapV3Path(path []byte, exactOutput bool) ([]common.Address, []uint32, error) {
+	if len(path) < 43 || (len(path)-20)%23 != 0 {
+		return nil, nil, fmt.Errorf("invalid v3 path length %d", len(path))
+	}
+	hops := (len(path) - 20) / 23
+	tokens := make([]common.Address, 0, hops+1)
+	fees := make([]uint32, 0, hops)
+	for i := 0; i < hops; i++ {
+		offset := i * 23
+		tokens = append(tokens, common.BytesToAddress(path[offset:offset+20]))
+		fee := make([]byte, 4)
+		copy(fee[1:], path[offset+20:offset+23])
+		fees = append(fees, binary.BigEndian.Uint32(fee))
+	}
+	tokens = append(tokens, common.BytesToAddress(path[len(path)-20:]))
+	if exactOutput {
+		for i, j := 0, len(tokens)-1; i < j; i, j = i+1, j-1 {
+			tokens[i], tokens[j] = tokens[j], tokens[i]
+		}
+		for i, j := 0, len(fees)-1; i < j; i, j = i+1, j-1 {
+			fees[i], fees[j] = fees[j], fees[i]
+		}
+	}
+	return tokens, fees, nil
+}
+
+// Decode a single swap call, returns nil (and no error) for calls that aren't swaps (unwrapWETH9, refundETH etc)
+func decodeUniswapV3Swap(call []byte) (*uniswapV3Swap, error) {
+	if len(call) < 4 {
+		return nil, nil
+	}
+	selector := call[:4]
+	switch {
+	case bytes.Equal(selector, uniV3ExactInputSingle):
+		var params uniswapV3.ISwapRouterExactInputSingleParams
+		if err := swapRouterAbi.Methods["exactInputSingle"].Inputs.Unpack(&params, call[4:]); err != nil {
+			return nil, err
+		}
+		return &uniswapV3Swap{Path: []common.Address{params.TokenIn, params.TokenOut}, Fees: []uint32{uint32(params.Fee.Uint64())}, Recipient: params.Recipient, Deadline: params.Deadline,
+			Amount: params.AmountIn, Limit: params.AmountOutMinimum, SqrtPriceLimitX96: params.SqrtPriceLimitX96}, nil
+	case bytes.Equal(selector, uniV3ExactOutputSingle):
+		var params uniswapV3.ISwapRouterExactOutputSingleParams
+		if err := swapRouterAbi.Methods["exactOutputSingle"].Inputs.Unpack(&params, call[4:]); err != nil {
+			return nil, err
+		}
+		return &uniswapV3Swap{Path: []common.Address{params.TokenIn, params.TokenOut}, Fees: []uint32{uint32(params.Fee.Uint64())}, Recipient: params.Recipient, Deadline: params.Deadline,
+			ExactOutput: true, Amount: params.AmountOut, Limit: params.AmountInMaximum, SqrtPriceLimitX96: params.SqrtPriceLimitX96}, nil
+	case bytes.Equal(selector, uniV3ExactInput):
+		var params uniswapV3.ISwapRouterExactInputParams
+		if err := swapRouterAbi.Methods["exactInput"].Inputs.Unpack(&params, call[4:]); err != nil {
+			return nil, err
+		}
+		path, fees, err := decodeUniswapV3Path(params.Path, false)
+		if err != nil {
+			return nil, err
+		}
+		return &uniswapV3Swap{Path: path, Fees: fees, Recipient: params.Recipient, Deadline: params.Deadline, Amount: params.AmountIn, Limit: params.AmountOutMinimum}, nil
+	case bytes.Equal(selector, uniV3ExactOutput):
+		var params uniswapV3.ISwapRouterExactOutputParams
+		if err := swapRouterAbi.Methods["exactOutput"].Inputs.Unpack(&params, call[4:]); err != nil {
+			return nil, err
+		}
+		path, fees, err := decodeUniswapV3Path(params.Path, true)
+		if err != nil {
+			return nil, err
+		}
+		return &uniswapV3Swap{Path: path, Fees: fees, Recipient: params.Recipient, Deadline: params.Deadline, ExactOutput: true, Amount: params.AmountOut, Limit: params.AmountInMaximum}, nil
+	case bytes.Equal(selector, uniV3ExactInputSingle02):
+		var params uniswapV3.IV3SwapRouterExactInputSingleParams
+		if err := swapRouter02Abi.Methods["exactInputSingle"].Inputs.Unpack(&params, call[4:]); err != nil {
+			return nil, err
+		}
+		return &uniswapV3Swap{Path: []common.Address{params.TokenIn, params.TokenOut}, Fees: []uint32{uint32(params.Fee.Uint64())}, Recipient: params.Recipient,
+			Amount: params.AmountIn, Limit: params.AmountOutMinimum, SqrtPriceLimitX96: params.SqrtPriceLimitX96}, nil
+	case bytes.Equal(selector, uniV3ExactOutputSingle02):
+		var params uniswapV3.IV3SwapRouterExactOutputSingleParams
+		if err := swapRouter02Abi.Methods["exactOutputSingle"].Inputs.Unpack(&params, call[4:]); err != nil {
+			return nil, err
+		}
+		return &uniswapV3Swap{Path: []common.Address{params.TokenIn, params.TokenOut}, Fees: []uint32{uint32(params.Fee.Uint64())}, Recipient: params.Recipient,
+			ExactOutput: true, Amount: params.AmountOut, Limit: params.AmountInMaximum, SqrtPriceLimitX96: params.SqrtPriceLimitX96}, nil
+	case bytes.Equal(selector, uniV3ExactInput02):
+		var params uniswapV3.IV3SwapRouterExactInputParams
+		if err := swapRouter02Abi.Methods["exactInput"].Inputs.Unpack(&params, call[4:]); err != nil {
+			return nil, err
+		}
+		path, fees, err := decodeUniswapV3Path(params.Path, false)
+		if err != nil {
+			return nil, err
+		}
+		return &uniswapV3Swap{Path: path, Fees: fees, Recipient: params.Recipient, Amount: params.AmountIn, Limit: params.AmountOutMinimum}, nil
+	case bytes.Equal(selector, uniV3ExactOutput02):
+		var params uniswapV3.IV3SwapRouterExactOutputParams
+		if err := swapRouter02Abi.Methods["exactOutput"].Inputs.Unpack(&params, call[4:]); err != nil {
+			return nil, err
+		}
+		path, fees, err := decodeUniswapV3Path(params.Path, true)
+		if err != nil {
+			return nil, err
+		}
+		return &uniswapV3Swap{Path: path, Fees: fees, Recipient: params.Recipient, ExactOutput: true, Amount: params.AmountOut, Limit: params.AmountInMaximum}, nil
+	}
+	return nil, nil
+}
+
+// Split a multicall into its calls, anything else is a single call. Also returns the multicall level deadline (SwapRouter02) if any
+func splitUniswapV3Multicall(data []byte) ([][]byte, *big.Int, error) {
+	if len(data) < 4 {
+		return nil, nil, fmt.Errorf("calldata too short")
+	}
+	selector := data[:4]
+	if !bytes.Equal(selector, uniV3Multicall) && !bytes.Equal(selector, uniV3MulticallDeadline) && !bytes.Equal(selector, uniV3MulticallPreviousBlockhash) {
+		return [][]byte{data}, nil, nil
+	}
+	method, err := swapRouter02Abi.MethodById(selector)
+	if err != nil {
+		return nil, nil, err
+	}
+	values, err := method.Inputs.UnpackValues(data[4:])
+	if err != nil {
+		return nil, nil, err
+	}
+	calls := values[len(values)-1].([][]byte)
+	if bytes.Equal(selector, uniV3MulticallDeadline) {
+		return calls, values[0].(*big.Int), nil
+	}
+	return calls, nil, nil
+}
+
+func handleUniswapV3Trade(tx *types.Transaction, client *ethclient.Client, isStealth bool, fullMode bool) {
+	calls, deadline, err := splitUniswapV3Multicall(tx.Data())
+	if err != nil {
+		fmt.Println("Error decoding uniswap v3 multicall:", tx.Hash().Hex(), err)
+		if fullMode {
+			handleMiscTx(tx, client, isStealth)
+		}
+		return
+	}
+	// WETH output gets unwrapped to ETH when the batch ends with unwrapWETH9
+	unwrapsETH := false
+	for _, call := range calls {
+		if len(call) >= 4 && (bytes.Equal(call[:4], uniV3UnwrapWETH9) || bytes.Equal(call[:4], uniV3UnwrapWETH902)) {
+			unwrapsETH = true
+		}
+	}
+	sender := getTxSenderAddress(tx, client)
+	swaps := 0
+	for _, call := range calls {
+		swap, err := decodeUniswapV3Swap(call)
+		if err != nil {
+			fmt.Println("Error decoding uniswap v3 swap:", tx.Hash().Hex(), err)
+			continue
+		}
+		if swap == nil {
+			continue
+		}
+		swaps++
+		if swap.Deadline == nil {
+			swap.Deadline = deadline
+		}
+		final := uniswapV3TradeFinal(tx, swap, sender, unwrapsETH, client)
+		fmt.Println()
+		fmt.Println(Red("New TX: " + final.Venue + " Trade"))
+		fmt.Println("Hash: ", tx.Hash().Hex(), " Amount: ", final.AmountIn, getTokenSymbol(swap.Path[0], client), " For: ", final.OutputTokenSymbol, " Fees: ", final.FeeTiers)
+		if fullMode {
+			handleUniFinalTrade(tx, client, isStealth, final)
+		}
+	}
+	// Router calls without a swap (refunds, sweeps, permits)
+	if swaps == 0 && fullMode {
+		handleMiscTx(tx, client, isStealth)
+	}
+}
+
+// Map a V3 swap onto the V2 trade document (exact output trades store amountInMaximum/amountOut like V2 does)
+func uniswapV3TradeFinal(tx *types.Transaction, swap *uniswapV3Swap, sender string, unwrapsETH bool, client *ethclient.Client) UniswapTradeFinal {
+	tokenIn, tokenOut := swap.Path[0], swap.Path[len(swap.Path)-1]
+	amountIn, amountOutMin := swap.Amount, swap.Limit
+	if swap.ExactOutput {
+		amountIn, amountOutMin = swap.Limit, swap.Amount
+	}
+	path := make([]string, len(swap.Path))
+	for i, token := range swap.Path {
+		path[i] = token.Hex()
+	}
+	recipient := swap.Recipient.Hex()
+	if swap.Recipient == uniV3MsgSender {
+		recipient = sender
+	} else if swap.Recipient == uniV3AddressThis || swap.Recipient == (common.Address{}) {
+		// Kept by the router for a following unwrapWETH9/sweepToken
+		recipient = tx.To().Hex()
+	}
+	final := UniswapTradeFinal{
+		Venue:             uniV3VenueName,
+		AmountIn:          formatERC20Decimals(amountIn, tokenIn, client),
+		AmountOutMin:      formatERC20Decimals(amountOutMin, tokenOut, client),
+		Path:              path,
+		To:                recipient,
+		OutputTokenSymbol: getTokenSymbol(tokenOut, client),
+		OutputTokenName:   getTokenName(tokenOut, client),
+		FeeTiers:          swap.Fees,
+	}
+	if swap.Deadline != nil {
+		final.Deadline = swap.Deadline.Int64()
+	}
+	if tokenOut == wethAddress && unwrapsETH {
+		final.OutputTokenSymbol = "ETH"
+		final.OutputTokenName = "Ether"
+	}
+	if swap.SqrtPriceLimitX96 != nil && swap.SqrtPriceLimitX96.Sign() > 0 {
+		final.SqrtPriceLimitX96 = swap.SqrtPriceLimitX96.String()
+	}
+	return final
+}
@@ -0,0 +1,423 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	. "github.com/logrusorgru/aurora"
+)
+
+// Watchlist of addresses (competitor bots, our hot wallets, pools...) that raise an alert whenever a tx touches them
+// "Touching" means showing up anywhere in the classified document: sender, recipient, decoded arguments, swap paths, inner calls
+// and the simulated logs (with -localExec), plus any ABI encoded address in the raw calldata for methods we can't decode
+// Listed in ./data/watchlist.csv (override with WATCHLIST_PATH in .env) in the label format (`address,name,category`), edited with `-watch`/`-unwatch`
+// and re-read within a few seconds of a change
+// Alerts carry the document of the tx and go to every target in -alerts: stdout, a file (one JSON alert per line) or a webhook (POSTed as JSON)
+// Txs that raised an alert while pending raise a second one once they're mined (see alertWatchlistMinedInBlock)
+var watchlistAlertTargets = "stdout"
+
+func SetWatchlistAlertTargets(targets string) {
+	watchlistAlertTargets = targets
+}
+
+const defaultWatchlistPath = "./data/watchlist.csv"
+
+// How often the file is checked for changes
+const watchlistReloadInterval = 10
+
+// Pending txs that raised an alert are remembered until they're mined, up to this many
+const watchlistMaxPendingAlerts = 10000
+
+// Pending alerts older than this are dropped, the tx was replaced or is never getting mined
+const watchlistPendingAlertTTL = 3600
+
+// How often expired pending alerts are swept
+const watchlistPendingAlertSweepInterval = 60
+
+const webhookTimeout = 5 * time.Second
+
+const (
+	watchlistStatusPending = "pending"
+	watchlistStatusStealth = "stealth" // Mined without ever showing up in our mempool
+	watchlistStatusMined   = "mined"
+)
+
+type watchlistMatch struct {
+	Address  string `json:"address"`
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	Field    string `json:"field"` // Document field the address was found in ("from", "to", "path", "localLogs"...), "calldata" for undecoded args
+}
+
+type pendingWatchlistAlert struct {
+	matches []watchlistMatch
+	seenAt  int64
+}
+
+type watchlistAlert struct {
+	Time     int64            `json:"time"`
+	TxHash   string           `json:"txHash"`
+	Status   string           `json:"status"` // "pending", "stealth" or "mined"
+	Matches  []watchlistMatch `json:"matches"`
+	Document json.RawMessage  `json:"document"`
+}
+
+var watchlist = struct {
+	lock    sync.RWMutex
+	entries map[common.Address]*addressLabel
+	// Size + mod time of the file the entries were read from
+	fingerprint string
+	checkedAt   int64
+	// Pending txs that raised an alert => what they matched
+	pendingAlerts map[common.Hash]*pendingWatchlistAlert
+	sweptAt       int64
+}{
+	entries:       make(map[common.Address]*addressLabel),
+	pendingAlerts: make(map[common.Hash]*pendingWatchlistAlert),
+}
+
+var watchlistAlertFileLock sync.Mutex
+
+var documentAddressRegex = regexp.MustCompile(`\b0x[0-9a-fA-F]{40}\b`)
+
+func getWatchlistPath() string {
+	if path := os.Getenv("WATCHLIST_PATH"); path != "" {
+		return path
+	}
+	return defaultWatchlistPath
+}
+
+// Re-read the file if it changed since the last check, must be called without the lock held
+func refreshWatchlist(force bool) {
+	now := time.Now().Unix()
+	watchlist.lock.RLock()
+	due := force || watchlist.checkedAt+watchlistReloadInterval <= now
+	watchlist.lock.RUnlock()
+	if !due {
+		return
+	}
+	watchlist.lock.Lock()
+	defer watchlist.lock.Unlock()
+	watchlist.checkedAt = now
+	info, err := os.Stat(getWatchlistPath())
+	if err != nil {
+		watchlist.entries = make(map[common.Address]*addressLabel)
+		watchlist.fingerprint = ""
+		return
+	}
+	fingerprint := fmt.Sprintf("%d:%d", info.Size(), info.ModTime().UnixNano())
+	if fingerprint == watchlist.fingerprint {
+		return
+	}
+	watchlist.fingerprint = fingerprint
+	buf, err := ioutil.ReadFile(getWatchlistPath())
+	if err != nil {
+		return
+	}
+	labels, err := parseAddressLabelsCSV(buf)
+	if err != nil {
+		fmt.Println("Error parsing watchlist:", err)
+		return
+	}
+	entries := make(map[common.Address]*addressLabel)
+	for _, entry := range labels {
+		if !common.IsHexAddress(entry.Address) {
+			fmt.Println("Skipping invalid watchlist address", entry.Address)
+			continue
+		}
+		entries[common.HexToAddress(entry.Address)] = entry
+	}
+	watchlist.entries = entries
+	fmt.Println("Watching", len(entries), "addresses")
+}
+
+// Every watched address in a document, with the top level field it was found in
+func findWatchedAddressesInDocument(document []byte, found func(address common.Address, field string)) {
+	var fields map[string]interface{}
+	if json.Unmarshal(document, &fields) != nil {
+		return
+	}
+	var walk func(field string, value interface{})
+	walk = func(field string, value interface{}) {
+		switch v := value.(type) {
+		case string:
+			for _, match := range documentAddressRegex.FindAllString(v, -1) {
+				found(common.HexToAddress(match), field)
+			}
+		case []interface{}:
+			for _, item := range v {
+				walk(field, item)
+			}
+		case map[string]interface{}:
+			for _, item := range v {
+				walk(field, item)
+			}
+		}
+	}
+	// Sorted so an address found in several fields is always reported under the same one
+	names := make([]string, 0, len(fields))
+	for field := range fields {
+		names = append(names, field)
+	}
+	sort.Strings(names)
+	for _, field := range names {
+		walk(field, fields[field])
+	}
+}
+
+// Addresses passed as ABI encoded args, found in the calldata words whether we could decode the method or not
+func findWatchedAddressesInCalldata(data []byte, found func(address common.Address, field string)) {
+	for offset := 4; offset+32 <= len(data); offset += 32 {
+		word := data[offset : offset+32]
+		if bytes.Equal(word[:12], make([]byte, 12)) {
+			found(common.BytesToAddress(word), "calldata")
+		}
+	}
+}
+
+// What the tx touches on the watchlist, nil if nothing
+func findWatchlistMatches(tx *types.Transaction, client *ethclient.Client, document []byte) []watchlistMatch {
+	watchlist.lock.RLock()
+	defer watchlist.lock.RUnlock()
+	var matches []watchlistMatch
+	seen := make(map[common.Address]bool)
+	found := func(address common.Address, field string) {
+		entry, ok := watchlist.entries[address]
+		if !ok || seen[address] {
+			return
+		}
+		seen[address] = true
+		matches = append(matches, watchlistMatch{Address: address.Hex(), Name: entry.Label, Category: entry.Category, Field: field})
+	}
+	found(common.HexToAddress(getTxSenderAddress(tx, client)), "from")
+	if tx.To() != nil {
+		found(*tx.To(), "to")
+	}
+	findWatchedAddressesInDocument(document, found)
+	findWatchedAddressesInCalldata(tx.Data(), found)
+	return matches
+}
+
+// Quick mode doesn't build documents, alerts and rules get the basics instead
+func getTxSummaryDocument(tx *types.Transaction, client *ethclient.Client, isStealth bool) []byte {
+	body := struct {
+		Hash       string      `json:"txHash"`
+		From       string      `json:"from"`
+		To         string      `json:"to"`
+		Value      float64     `json:"txValue"`
+		Nonce      uint64      `json:"nonce"`
+		GasPrice   float64     `json:"gasPrice"`
+		Stealth    bool        `json:"txStealth"`
+		InnerCalls []innerCall `json:"innerCalls"`
+		addressLabels
+	}{}
+	body.Hash = tx.Hash().Hex()
+	body.From = getTxSenderAddress(tx, client)
+	if tx.To() != nil {
+		body.To = tx.To().Hex()
+	}
+	body.Value = formatEthWeiToEther(tx.Value())
+	body.Nonce = tx.Nonce()
+	body.GasPrice = formatEthWeiToEther(tx.GasPrice())
+	body.Stealth = isStealth
+	body.InnerCalls = getInnerCalls(tx, client)
+	body.addressLabels = getAddressLabels(tx, client)
+	jsonBytes, _ := json.Marshal(body)
+	return jsonBytes
+}
+
+func sendWatchlistAlert(alert watchlistAlert) {
+	jsonBytes, err := json.Marshal(alert)
+	if err != nil {
+		fmt.Println("Error encoding watchlist alert:", err)
+		return
+	}
+	names := make([]string, len(alert.Matches))
+	for i, match := range alert.Matches {
+		names[i] = match.Name + " (" + match.Field + ")"
+	}
+	for _, target := range strings.Split(watchlistAlertTargets, ",") {
+		target = strings.TrimSpace(target)
+		switch {
+		case target == "":
+		case target == "stdout":
+			fmt.Println()
+			fmt.Println(BgRed("Watchlist alert (" + alert.Status + "): " + strings.Join(names, ", ")))
+			fmt.Println(string(jsonBytes))
+		case strings.HasPrefix(target, "http://") || strings.HasPrefix(target, "https://"):
+			go postWebhook(target, jsonBytes)
+		default:
+			appendAlertToFile(target, jsonBytes)
+		}
+	}
+}
+
+func appendAlertToFile(path string, jsonBytes []byte) {
+	watchlistAlertFileLock.Lock()
+	defer watchlistAlertFileLock.Unlock()
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		fmt.Println("Error opening alert file:", err)
+		return
+	}
+	defer f.Close()
+	if _, err := f.Write(append(jsonBytes, '\n')); err != nil {
+		fmt.Println("Error writing alert file:", err)
+	}
+}
+
+func postWebhook(url string, jsonBytes []byte) {
+	client := http.Client{Timeout: webhookTimeout}
+	res, err := client.Post(url, "application/json", bytes.NewReader(jsonBytes))
+	if err != nil {
+		fmt.Println("Error posting to webhook:", err)
+		return
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= 300 {
+		fmt.Println("Webhook returned", res.Status)
+	}
+}
+
+// Check a classified tx against the watchlist, `document` is what's indexed for it (nil in quick mode)
+func alertWatchlist(tx *types.Transaction, client *ethclient.Client, isStealth bool, document []byte) {
+	refreshWatchlist(false)
+	watchlist.lock.RLock()
+	empty := len(watchlist.entries) == 0
+	watchlist.lock.RUnlock()
+	if empty {
+		return
+	}
+	if document == nil {
+		document = getTxSummaryDocument(tx, client, isStealth)
+	}
+	matches := findWatchlistMatches(tx, client, document)
+	if len(matches) == 0 {
+		return
+	}
+	status := watchlistStatusPending
+	if isStealth {
+		status = watchlistStatusStealth
+	} else {
+		watchlist.lock.Lock()
+		trackPendingWatchlistAlert(tx.Hash(), matches)
+		watchlist.lock.Unlock()
+	}
+	sendWatchlistAlert(watchlistAlert{Time: time.Now().Unix(), TxHash: tx.Hash().Hex(), Status: status, Matches: matches, Document: document})
+}
+
+// Remember a pending alert until the tx is mined, must be called with the lock held
+// Expired alerts are swept every minute, the oldest one makes room when the map is still full
+func trackPendingWatchlistAlert(hash common.Hash, matches []watchlistMatch) {
+	now := time.Now().Unix()
+	if watchlist.sweptAt+watchlistPendingAlertSweepInterval <= now {
+		watchlist.sweptAt = now
+		for pendingHash, pending := range watchlist.pendingAlerts {
+			if pending.seenAt+watchlistPendingAlertTTL <= now {
+				delete(watchlist.pendingAlerts, pendingHash)
+			}
+		}
+	}
+	if _, ok := watchlist.pendingAlerts[hash]; !ok && len(watchlist.pendingAlerts) >= watchlistMaxPendingAlerts {
+		var oldestHash common.Hash
+		oldest := now + 1
+		for pendingHash, pending := range watchlist.pendingAlerts {
+			if pending.seenAt < oldest {
+				oldestHash, oldest = pendingHash, pending.seenAt
+			}
+		}
+		delete(watchlist.pendingAlerts, oldestHash)
+	}
+	watchlist.pendingAlerts[hash] = &pendingWatchlistAlert{matches: matches, seenAt: now}
+}
+
+// Follow up on a pending alert once the tx is mined, `update` is the partial document update
+func alertWatchlistMined(txHash string, update []byte) {
+	watchlist.lock.Lock()
+	pending, ok := watchlist.pendingAlerts[common.HexToHash(txHash)]
+	delete(watchlist.pendingAlerts, common.HexToHash(txHash))
+	watchlist.lock.Unlock()
+	if ok {
+		sendWatchlistAlert(watchlistAlert{Time: time.Now().Unix(), TxHash: txHash, Status: watchlistStatusMined, Matches: pending.matches, Document: update})
+	}
+}
+
+// Mined blocks aren't indexed yet, the alerted txs in a block get the `txMined`/`txFailed`/`blockIncluded` update TxMinedUpdate would write, built from their receipt
+func alertWatchlistMinedInBlock(block *types.Block, client *ethclient.Client) {
+	for _, tx := range block.Transactions() {
+		watchlist.lock.RLock()
+		_, ok := watchlist.pendingAlerts[tx.Hash()]
+		watchlist.lock.RUnlock()
+		if !ok {
+			continue
+		}
+		receipt, err := client.TransactionReceipt(context.Background(), tx.Hash())
+		if err != nil {
+			fmt.Println("Error getting the receipt of", tx.Hash().Hex(), err)
+			continue
+		}
+		body := struct {
+			Doc struct {
+				TxMined       bool  `json:"txMined"`
+				TxFailed      bool  `json:"txFailed"`
+				BlockIncluded int64 `json:"blockIncluded"`
+			} `json:"doc"`
+		}{}
+		body.Doc.TxMined = true
+		body.Doc.TxFailed = receipt.Status != types.ReceiptStatusSuccessful
+		body.Doc.BlockIncluded = block.Number().Int64()
+		jsonBytes, _ := json.Marshal(body)
+		alertWatchlistMined(tx.Hash().Hex(), jsonBytes)
+	}
+}
+
+// `./helios -watch=0xAddress,Name,category`
+func AddWatchedAddress(entry string) {
+	address, name, category := parseAddressCSVArg(entry)
+	removeWatchedAddressFromFile(address)
+	if err := appendAddressCSVRow(getWatchlistPath(), []string{address.Hex(), name, category}); err != nil {
+		log.Fatalln("Error saving watchlist:", err)
+	}
+	fmt.Println("Watching", address.Hex(), "as", name, category)
+}
+
+func removeWatchedAddressFromFile(address common.Address) bool {
+	buf, err := ioutil.ReadFile(getWatchlistPath())
+	if err != nil {
+		return false
+	}
+	updated, removed := removeAddressFromCSV(buf, address)
+	if !removed {
+		return false
+	}
+	if err := ioutil.WriteFile(getWatchlistPath(), updated, 0644); err != nil {
+		log.Fatalln("Error saving watchlist:", err)
+	}
+	return true
+}
+
+// `./helios -unwatch=0xAddress`
+func RemoveWatchedAddress(address string) {
+	if !common.IsHexAddress(address) {
+		log.Fatalln("Invalid address:", address)
+	}
+	if removeWatchedAddressFromFile(common.HexToAddress(address)) {
+		fmt.Println("Stopped watching", address)
+	} else {
+		fmt.Println(address, "isn't on the watchlist")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	"log"
 	"path/filepath"
+	"strings"
 
 	"github.com/elastic/go-elasticsearch/v8"
 	"github.com/elastic/go-elasticsearch/v8/esapi"
@@ -26,8 +27,9 @@ func Seed4Bytes() {
 	for _, f := range files {
 		fileValue, _ := filepath.Abs("./data/fn-signatures/" + f.Name())
 		buf, _ := ioutil.ReadFile(fileValue)
-		value := string(buf)
-		insert4BytesKV(f.Name(), value)
+		// Files are named after the selector and hold the signature
+		value := strings.TrimSpace(string(buf))
+		insert4BytesKV(value, f.Name())
 
 	}
 }